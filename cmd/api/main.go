@@ -5,6 +5,7 @@ import (
 
 	_ "github.com/brunobarlari/inventorypulse/docs"
 	"github.com/brunobarlari/inventorypulse/internal/config"
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
 	"github.com/brunobarlari/inventorypulse/internal/handler"
 	"github.com/brunobarlari/inventorypulse/internal/middleware"
 	"github.com/brunobarlari/inventorypulse/internal/repository"
@@ -81,17 +82,99 @@ func main() {
 	categoryRepo := repository.NewCategoryRepository(db)
 	productRepo := repository.NewProductRepository(db)
 	productHistoryRepo := repository.NewProductHistoryRepository(db)
+	warehouseRepo := repository.NewWarehouseRepository(db)
+	movementRepo := repository.NewStockMovementRepository(db)
+	reservationRepo := repository.NewReservationRepository(db)
+	alertRepo := repository.NewStockAlertRepository(db)
+	supplierRepo := repository.NewSupplierRepository(db)
+	purchaseOrderRepo := repository.NewPurchaseOrderRepository(db)
+	salesOrderRepo := repository.NewSalesOrderRepository(db)
+	transferRepo := repository.NewTransferRepository(db)
+	lotRepo := repository.NewLotRepository(db)
+	variantRepo := repository.NewProductVariantRepository(db)
+	bundleRepo := repository.NewBundleRepository(db)
+	serialRepo := repository.NewSerialNumberRepository(db)
+	workOrderRepo := repository.NewWorkOrderRepository(db)
+	unitRepo := repository.NewProductUnitRepository(db)
+	stocktakeRepo := repository.NewStocktakeRepository(db)
+	valuationRepo := repository.NewValuationRepository(db)
+	priceListRepo := repository.NewPriceListRepository(db)
+	priceChangeRepo := repository.NewPriceChangeRepository(db)
+	taxRepo := repository.NewTaxRepository(db)
+	returnRepo := repository.NewReturnRepository(db)
+	forecastRepo := repository.NewForecastRepository(db)
+	analysisRepo := repository.NewStockAnalysisRepository(db)
+	statsRepo := repository.NewStatsRepository(db)
 
 	// Initialize services
 	authService := service.NewAuthService(userRepo, jwtService)
 	categoryService := service.NewCategoryService(categoryRepo, wsHub)
-	productService := service.NewProductService(productRepo, productHistoryRepo, wsHub)
+	alertService := service.NewAlertService(alertRepo, wsHub)
+	productService := service.NewProductService(productRepo, productHistoryRepo, movementRepo, alertService, wsHub)
+	warehouseService := service.NewWarehouseService(warehouseRepo, wsHub)
+	reservationService := service.NewReservationService(reservationRepo, productRepo, alertService, cfg.Reservation.DefaultTTL, wsHub)
+	supplierService := service.NewSupplierService(supplierRepo)
+	purchaseOrderService := service.NewPurchaseOrderService(purchaseOrderRepo, productRepo, alertService, wsHub)
+	salesOrderService := service.NewSalesOrderService(salesOrderRepo, productRepo, alertService, wsHub)
+	transferService := service.NewTransferService(transferRepo, productRepo, alertService, wsHub)
+	lotService := service.NewLotService(lotRepo, productRepo)
+	variantService := service.NewProductVariantService(variantRepo, productRepo, productHistoryRepo, wsHub)
+	bundleService := service.NewBundleService(bundleRepo, productRepo, alertService, wsHub)
+	serialService := service.NewSerialNumberService(serialRepo)
+	workOrderService := service.NewWorkOrderService(workOrderRepo, productRepo, alertService, wsHub)
+	unitService := service.NewProductUnitService(unitRepo, productRepo, wsHub)
+	stocktakeService := service.NewStocktakeService(stocktakeRepo, productRepo, alertService, wsHub)
+	valuationService := service.NewValuationService(valuationRepo, cfg.Costing.Method)
+	priceListService := service.NewPriceListService(priceListRepo, productRepo)
+	priceChangeService := service.NewPriceChangeService(priceChangeRepo, productRepo, wsHub)
+	taxService := service.NewTaxService(taxRepo)
+	returnService := service.NewReturnService(returnRepo, productRepo, alertService, wsHub)
+	forecastService := service.NewForecastService(forecastRepo, models.ReplenishmentParams{
+		Method:          cfg.Forecast.Method,
+		WindowDays:      cfg.Forecast.WindowDays,
+		Alpha:           cfg.Forecast.Alpha,
+		LeadTimeDays:    cfg.Forecast.LeadTimeDays,
+		SafetyStockDays: cfg.Forecast.SafetyStockDays,
+		CoverDays:       cfg.Forecast.CoverDays,
+		Location:        cfg.Forecast.Location,
+	})
+	analysisService := service.NewStockAnalysisService(analysisRepo)
+	statsService := service.NewStatsService(statsRepo, wsHub)
+
+	// Release expired reservations in the background
+	go reservationService.RunExpirySweeper(cfg.Reservation.SweepInterval)
+
+	// Apply scheduled price changes in the background
+	go priceChangeService.RunScheduler(cfg.PriceSchedule.Interval)
+
+	// Push changed dashboard stats in the background
+	go statsService.RunBroadcaster(cfg.Stats.BroadcastInterval)
 
 	// Initialize handlers
 	authHandler := handler.NewAuthHandler(authService)
 	categoryHandler := handler.NewCategoryHandler(categoryService)
-	productHandler := handler.NewProductHandler(productService)
+	productHandler := handler.NewProductHandler(productService, priceListService, taxService)
 	searchHandler := handler.NewSearchHandler(productService, categoryService)
+	warehouseHandler := handler.NewWarehouseHandler(warehouseService, productService)
+	reservationHandler := handler.NewReservationHandler(reservationService)
+	alertHandler := handler.NewAlertHandler(alertService)
+	supplierHandler := handler.NewSupplierHandler(supplierService)
+	purchaseOrderHandler := handler.NewPurchaseOrderHandler(purchaseOrderService)
+	salesOrderHandler := handler.NewSalesOrderHandler(salesOrderService)
+	transferHandler := handler.NewTransferHandler(transferService)
+	lotHandler := handler.NewLotHandler(lotService)
+	variantHandler := handler.NewProductVariantHandler(variantService)
+	bundleHandler := handler.NewBundleHandler(bundleService)
+	serialHandler := handler.NewSerialNumberHandler(serialService)
+	workOrderHandler := handler.NewWorkOrderHandler(workOrderService)
+	unitHandler := handler.NewProductUnitHandler(unitService)
+	stocktakeHandler := handler.NewStocktakeHandler(stocktakeService)
+	reportHandler := handler.NewReportHandler(valuationService, forecastService, analysisService)
+	priceListHandler := handler.NewPriceListHandler(priceListService)
+	priceChangeHandler := handler.NewPriceChangeHandler(priceChangeService)
+	taxClassHandler := handler.NewTaxClassHandler(taxService)
+	returnHandler := handler.NewReturnHandler(returnService)
+	statsHandler := handler.NewStatsHandler(statsService)
 
 	// Initialize middleware
 	authMiddleware := middleware.NewAuthMiddleware(authService)
@@ -151,6 +234,9 @@ func main() {
 		// Search route (unified search)
 		api.GET("/search", authMiddleware.RequireAuth(), searchHandler.Search)
 
+		// Stats route (dashboard aggregates)
+		api.GET("/stats", authMiddleware.RequireAuth(), statsHandler.Get)
+
 		// Category routes
 		categories := api.Group("/categories")
 		categories.Use(authMiddleware.RequireAuth())
@@ -175,6 +261,12 @@ func main() {
 			products.GET("", productHandler.List)
 			products.GET("/:id", productHandler.Get)
 			products.GET("/:id/history", productHandler.GetHistory)
+			products.GET("/:id/stock", productHandler.GetStockLevels)
+			products.GET("/:id/movements", productHandler.ListMovements)
+			products.GET("/:id/lots", lotHandler.ListByProduct)
+			products.GET("/:id/variants", variantHandler.List)
+			products.GET("/:id/price", priceListHandler.ResolvePrice)
+			products.GET("/:id/price-changes", priceChangeHandler.List)
 
 			// Admin only
 			productsAdmin := products.Group("")
@@ -184,8 +276,242 @@ func main() {
 				productsAdmin.PUT("/:id", productHandler.Update)
 				productsAdmin.DELETE("/:id", productHandler.Delete)
 				productsAdmin.PATCH("/:id/stock", productHandler.UpdateStock)
+				productsAdmin.POST("/:id/movements", productHandler.RecordMovement)
+				productsAdmin.POST("/:id/variants/generate", variantHandler.Generate)
+				productsAdmin.PUT("/:id/components", bundleHandler.SetComponents)
+				productsAdmin.PUT("/:id/units", unitHandler.SetUnits)
+				productsAdmin.POST("/:id/price-changes", priceChangeHandler.Create)
+				productsAdmin.POST("/:id/price-changes/:change_id/cancel", priceChangeHandler.Cancel)
+			}
+		}
+
+		// Warehouse routes
+		warehouses := api.Group("/warehouses")
+		warehouses.Use(authMiddleware.RequireAuth())
+		{
+			warehouses.GET("", warehouseHandler.List)
+			warehouses.GET("/:id", warehouseHandler.Get)
+			warehouses.GET("/:id/stock", warehouseHandler.ListStock)
+
+			// Admin only
+			warehousesAdmin := warehouses.Group("")
+			warehousesAdmin.Use(authMiddleware.RequireAdmin())
+			{
+				warehousesAdmin.POST("", warehouseHandler.Create)
+				warehousesAdmin.PUT("/:id", warehouseHandler.Update)
+				warehousesAdmin.DELETE("/:id", warehouseHandler.Delete)
+				warehousesAdmin.PUT("/:id/stock/:product_id", warehouseHandler.UpdateStock)
+			}
+		}
+
+		// Reservation routes
+		reservations := api.Group("/reservations")
+		reservations.Use(authMiddleware.RequireAuth())
+		{
+			reservations.GET("", reservationHandler.List)
+			reservations.GET("/:id", reservationHandler.Get)
+
+			// Admin only
+			reservationsAdmin := reservations.Group("")
+			reservationsAdmin.Use(authMiddleware.RequireAdmin())
+			{
+				reservationsAdmin.POST("", reservationHandler.Create)
+				reservationsAdmin.POST("/:id/confirm", reservationHandler.Confirm)
+				reservationsAdmin.POST("/:id/release", reservationHandler.Release)
+			}
+		}
+
+		// Stock alert routes
+		alerts := api.Group("/alerts")
+		alerts.Use(authMiddleware.RequireAuth())
+		{
+			alerts.GET("", alertHandler.List)
+			alerts.GET("/:id", alertHandler.Get)
+
+			// Admin only
+			alertsAdmin := alerts.Group("")
+			alertsAdmin.Use(authMiddleware.RequireAdmin())
+			{
+				alertsAdmin.POST("/:id/acknowledge", alertHandler.Acknowledge)
+				alertsAdmin.POST("/:id/resolve", alertHandler.Resolve)
+			}
+		}
+
+		// Supplier routes (admin only)
+		suppliers := api.Group("/suppliers")
+		suppliers.Use(authMiddleware.RequireAuth(), authMiddleware.RequireAdmin())
+		{
+			suppliers.GET("", supplierHandler.List)
+			suppliers.GET("/:id", supplierHandler.Get)
+			suppliers.POST("", supplierHandler.Create)
+			suppliers.PUT("/:id", supplierHandler.Update)
+			suppliers.DELETE("/:id", supplierHandler.Delete)
+		}
+
+		// Purchase order routes (admin only)
+		purchaseOrders := api.Group("/purchase-orders")
+		purchaseOrders.Use(authMiddleware.RequireAuth(), authMiddleware.RequireAdmin())
+		{
+			purchaseOrders.GET("", purchaseOrderHandler.List)
+			purchaseOrders.GET("/:id", purchaseOrderHandler.Get)
+			purchaseOrders.POST("", purchaseOrderHandler.Create)
+			purchaseOrders.PUT("/:id", purchaseOrderHandler.Update)
+			purchaseOrders.DELETE("/:id", purchaseOrderHandler.Delete)
+			purchaseOrders.POST("/:id/send", purchaseOrderHandler.Send)
+			purchaseOrders.POST("/:id/receive", purchaseOrderHandler.Receive)
+			purchaseOrders.POST("/:id/close", purchaseOrderHandler.Close)
+		}
+
+		// Sales order routes
+		salesOrders := api.Group("/sales-orders")
+		salesOrders.Use(authMiddleware.RequireAuth())
+		{
+			salesOrders.GET("", salesOrderHandler.List)
+			salesOrders.GET("/:id", salesOrderHandler.Get)
+
+			// Admin only
+			salesOrdersAdmin := salesOrders.Group("")
+			salesOrdersAdmin.Use(authMiddleware.RequireAdmin())
+			{
+				salesOrdersAdmin.POST("", salesOrderHandler.Create)
+				salesOrdersAdmin.POST("/:id/allocate", salesOrderHandler.Allocate)
+				salesOrdersAdmin.POST("/:id/pick", salesOrderHandler.Pick)
+				salesOrdersAdmin.POST("/:id/ship", salesOrderHandler.Ship)
+				salesOrdersAdmin.POST("/:id/cancel", salesOrderHandler.Cancel)
+			}
+		}
+
+		// Return (RMA) routes
+		returns := api.Group("/returns")
+		returns.Use(authMiddleware.RequireAuth())
+		{
+			returns.GET("", returnHandler.List)
+			returns.GET("/summary", returnHandler.Summary)
+			returns.GET("/:id", returnHandler.Get)
+
+			// Admin only
+			returnsAdmin := returns.Group("")
+			returnsAdmin.Use(authMiddleware.RequireAdmin())
+			{
+				returnsAdmin.POST("", returnHandler.Create)
+				returnsAdmin.POST("/:id/inspect", returnHandler.Inspect)
+				returnsAdmin.POST("/:id/dispose", returnHandler.Dispose)
 			}
 		}
+
+		// Lot routes
+		lots := api.Group("/lots")
+		lots.Use(authMiddleware.RequireAuth())
+		{
+			lots.GET("/expiring", lotHandler.ListExpiring)
+		}
+
+		// Serial number routes
+		serials := api.Group("/serials")
+		serials.Use(authMiddleware.RequireAuth())
+		{
+			serials.GET("/:serial", serialHandler.Trace)
+		}
+
+		// Transfer routes
+		transfers := api.Group("/transfers")
+		transfers.Use(authMiddleware.RequireAuth())
+		{
+			transfers.GET("", transferHandler.List)
+			transfers.GET("/:id", transferHandler.Get)
+
+			// Admin only
+			transfersAdmin := transfers.Group("")
+			transfersAdmin.Use(authMiddleware.RequireAdmin())
+			{
+				transfersAdmin.POST("", transferHandler.Create)
+				transfersAdmin.POST("/:id/dispatch", transferHandler.Dispatch)
+				transfersAdmin.POST("/:id/receive", transferHandler.Receive)
+				transfersAdmin.POST("/:id/cancel", transferHandler.Cancel)
+			}
+		}
+
+		// Work order routes
+		workOrders := api.Group("/work-orders")
+		workOrders.Use(authMiddleware.RequireAuth())
+		{
+			workOrders.GET("", workOrderHandler.List)
+			workOrders.GET("/:id", workOrderHandler.Get)
+
+			// Admin only
+			workOrdersAdmin := workOrders.Group("")
+			workOrdersAdmin.Use(authMiddleware.RequireAdmin())
+			{
+				workOrdersAdmin.POST("", workOrderHandler.Create)
+				workOrdersAdmin.POST("/:id/complete", workOrderHandler.Complete)
+				workOrdersAdmin.POST("/:id/cancel", workOrderHandler.Cancel)
+			}
+		}
+
+		// Stocktake routes; any authenticated user may submit counts
+		stocktakes := api.Group("/stocktakes")
+		stocktakes.Use(authMiddleware.RequireAuth())
+		{
+			stocktakes.GET("", stocktakeHandler.List)
+			stocktakes.GET("/:id", stocktakeHandler.Get)
+			stocktakes.POST("/:id/counts", stocktakeHandler.SubmitCounts)
+
+			// Admin only
+			stocktakesAdmin := stocktakes.Group("")
+			stocktakesAdmin.Use(authMiddleware.RequireAdmin())
+			{
+				stocktakesAdmin.POST("", stocktakeHandler.Create)
+				stocktakesAdmin.POST("/:id/approve", stocktakeHandler.Approve)
+				stocktakesAdmin.POST("/:id/reject", stocktakeHandler.Reject)
+			}
+		}
+
+		// Price list routes
+		priceLists := api.Group("/price-lists")
+		priceLists.Use(authMiddleware.RequireAuth())
+		{
+			priceLists.GET("", priceListHandler.List)
+			priceLists.GET("/:id", priceListHandler.Get)
+
+			// Admin only
+			priceListsAdmin := priceLists.Group("")
+			priceListsAdmin.Use(authMiddleware.RequireAdmin())
+			{
+				priceListsAdmin.POST("", priceListHandler.Create)
+				priceListsAdmin.PUT("/:id", priceListHandler.Update)
+				priceListsAdmin.DELETE("/:id", priceListHandler.Delete)
+				priceListsAdmin.PUT("/:id/prices", priceListHandler.SetPrices)
+			}
+		}
+
+		// Tax class routes
+		taxClasses := api.Group("/tax-classes")
+		taxClasses.Use(authMiddleware.RequireAuth())
+		{
+			taxClasses.GET("", taxClassHandler.List)
+			taxClasses.GET("/:id", taxClassHandler.Get)
+
+			// Admin only
+			taxClassesAdmin := taxClasses.Group("")
+			taxClassesAdmin.Use(authMiddleware.RequireAdmin())
+			{
+				taxClassesAdmin.POST("", taxClassHandler.Create)
+				taxClassesAdmin.PUT("/:id", taxClassHandler.Update)
+				taxClassesAdmin.DELETE("/:id", taxClassHandler.Delete)
+				taxClassesAdmin.PUT("/:id/rates", taxClassHandler.SetRates)
+			}
+		}
+
+		// Report routes (admin only)
+		reports := api.Group("/reports")
+		reports.Use(authMiddleware.RequireAuth(), authMiddleware.RequireAdmin())
+		{
+			reports.GET("/valuation", reportHandler.Valuation)
+			reports.GET("/replenishment", reportHandler.Replenishment)
+			reports.GET("/abc", reportHandler.ABC)
+			reports.GET("/dead-stock", reportHandler.DeadStock)
+			reports.GET("/snapshot", reportHandler.Snapshot)
+		}
 	}
 
 	// Start server
@@ -196,5 +196,12 @@ export const search = {
   },
 };
 
-export default { auth, categories, products, search };
+// Stats API
+export const stats = {
+  async get() {
+    return fetchAPI('/stats');
+  },
+};
+
+export default { auth, categories, products, search, stats };
 
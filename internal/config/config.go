@@ -3,15 +3,22 @@ package config
 import (
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
-	Admin    AdminConfig
+	Server        ServerConfig
+	Database      DatabaseConfig
+	JWT           JWTConfig
+	Admin         AdminConfig
+	Reservation   ReservationConfig
+	Costing       CostingConfig
+	PriceSchedule PriceScheduleConfig
+	Forecast      ForecastConfig
+	Stats         StatsConfig
 }
 
 type ServerConfig struct {
@@ -29,8 +36,8 @@ type DatabaseConfig struct {
 }
 
 type JWTConfig struct {
-	Secret            string
-	ExpiryHours       int
+	Secret             string
+	ExpiryHours        int
 	RefreshExpiryHours int
 }
 
@@ -39,12 +46,66 @@ type AdminConfig struct {
 	Password string
 }
 
+type ReservationConfig struct {
+	DefaultTTL    time.Duration
+	SweepInterval time.Duration
+}
+
+type CostingConfig struct {
+	Method string
+}
+
+type PriceScheduleConfig struct {
+	Interval time.Duration
+}
+
+type ForecastConfig struct {
+	Method          string
+	WindowDays      int
+	Alpha           float64
+	LeadTimeDays    int
+	SafetyStockDays int
+	CoverDays       int
+	Location        *time.Location
+}
+
+type StatsConfig struct {
+	BroadcastInterval time.Duration
+}
+
 func Load() (*Config, error) {
 	// Load .env file if it exists
 	_ = godotenv.Load()
 
 	jwtExpiry, _ := strconv.Atoi(getEnv("JWT_EXPIRY_HOURS", "24"))
 	jwtRefreshExpiry, _ := strconv.Atoi(getEnv("JWT_REFRESH_EXPIRY_HOURS", "168"))
+	reservationTTL, _ := strconv.Atoi(getEnv("RESERVATION_TTL_MINUTES", "30"))
+	reservationSweep, _ := strconv.Atoi(getEnv("RESERVATION_SWEEP_INTERVAL_SECONDS", "60"))
+	priceScheduleInterval, _ := strconv.Atoi(getEnv("PRICE_SCHEDULER_INTERVAL_SECONDS", "60"))
+	costingMethod := strings.ToLower(getEnv("COSTING_METHOD", "fifo"))
+	if costingMethod != "average" {
+		costingMethod = "fifo"
+	}
+	forecastMethod := strings.ToLower(getEnv("FORECAST_METHOD", "moving_average"))
+	if forecastMethod != "exponential" {
+		forecastMethod = "moving_average"
+	}
+	forecastWindow, _ := strconv.Atoi(getEnv("FORECAST_WINDOW_DAYS", "90"))
+	if forecastWindow < 1 {
+		forecastWindow = 90
+	}
+	forecastAlpha, _ := strconv.ParseFloat(getEnv("FORECAST_SMOOTHING_ALPHA", "0.3"), 64)
+	if forecastAlpha <= 0 || forecastAlpha > 1 {
+		forecastAlpha = 0.3
+	}
+	leadTime, _ := strconv.Atoi(getEnv("REPLENISHMENT_LEAD_TIME_DAYS", "14"))
+	safetyStock, _ := strconv.Atoi(getEnv("REPLENISHMENT_SAFETY_STOCK_DAYS", "7"))
+	coverDays, _ := strconv.Atoi(getEnv("REPLENISHMENT_COVER_DAYS", "30"))
+	forecastLocation, err := time.LoadLocation(getEnv("FORECAST_TIMEZONE", "UTC"))
+	if err != nil {
+		forecastLocation = time.UTC
+	}
+	statsInterval, _ := strconv.Atoi(getEnv("STATS_BROADCAST_INTERVAL_SECONDS", "5"))
 
 	return &Config{
 		Server: ServerConfig{
@@ -60,14 +121,36 @@ func Load() (*Config, error) {
 			SSLMode:  getEnv("DB_SSLMODE", "disable"),
 		},
 		JWT: JWTConfig{
-			Secret:            getEnv("JWT_SECRET", "default-secret-change-me"),
-			ExpiryHours:       jwtExpiry,
+			Secret:             getEnv("JWT_SECRET", "default-secret-change-me"),
+			ExpiryHours:        jwtExpiry,
 			RefreshExpiryHours: jwtRefreshExpiry,
 		},
 		Admin: AdminConfig{
 			Email:    getEnv("ADMIN_EMAIL", "admin@inventorypulse.com"),
 			Password: getEnv("ADMIN_PASSWORD", "admin123"),
 		},
+		Reservation: ReservationConfig{
+			DefaultTTL:    time.Duration(reservationTTL) * time.Minute,
+			SweepInterval: time.Duration(reservationSweep) * time.Second,
+		},
+		Costing: CostingConfig{
+			Method: costingMethod,
+		},
+		PriceSchedule: PriceScheduleConfig{
+			Interval: time.Duration(priceScheduleInterval) * time.Second,
+		},
+		Forecast: ForecastConfig{
+			Method:          forecastMethod,
+			WindowDays:      forecastWindow,
+			Alpha:           forecastAlpha,
+			LeadTimeDays:    leadTime,
+			SafetyStockDays: safetyStock,
+			CoverDays:       coverDays,
+			Location:        forecastLocation,
+		},
+		Stats: StatsConfig{
+			BroadcastInterval: time.Duration(statsInterval) * time.Second,
+		},
 	}, nil
 }
 
@@ -0,0 +1,50 @@
+package models
+
+// BundleComponent is a line of a bundle's bill of materials: one bundle is
+// made up of Quantity units of the component product
+type BundleComponent struct {
+	ID          uint     `gorm:"primaryKey" json:"id"`
+	BundleID    uint     `gorm:"not null;uniqueIndex:idx_bundle_components_bundle_component" json:"bundle_id"`
+	ComponentID uint     `gorm:"not null;uniqueIndex:idx_bundle_components_bundle_component;index" json:"component_id"`
+	Component   Product  `gorm:"foreignKey:ComponentID" json:"-"`
+	Quantity    Quantity `gorm:"not null;type:decimal(14,3)" json:"quantity" swaggertype:"number"`
+}
+
+// TableName specifies the table name for BundleComponent model
+func (BundleComponent) TableName() string {
+	return "bundle_components"
+}
+
+// BundleComponentResponse is the DTO for a bundle component with its stock
+type BundleComponentResponse struct {
+	ProductID uint     `json:"product_id"`
+	Name      string   `json:"name"`
+	SKU       string   `json:"sku"`
+	Quantity  Quantity `json:"quantity" swaggertype:"number"`
+	Stock     Quantity `json:"stock" swaggertype:"number"`
+	Available Quantity `json:"available" swaggertype:"number"`
+}
+
+// ToResponse converts BundleComponent to BundleComponentResponse
+func (c *BundleComponent) ToResponse() BundleComponentResponse {
+	return BundleComponentResponse{
+		ProductID: c.ComponentID,
+		Name:      c.Component.Name,
+		SKU:       c.Component.SKU,
+		Quantity:  c.Quantity,
+		Stock:     c.Component.Stock,
+		Available: c.Component.Available(),
+	}
+}
+
+// BundleComponentRequest is the DTO for one line of a bill of materials
+type BundleComponentRequest struct {
+	ProductID uint     `json:"product_id" binding:"required"`
+	Quantity  Quantity `json:"quantity" binding:"required,gt=0" swaggertype:"number"`
+}
+
+// SetBundleComponentsRequest is the DTO for replacing a product's bill of
+// materials. An empty list turns the bundle back into a regular product.
+type SetBundleComponentsRequest struct {
+	Components []BundleComponentRequest `json:"components" binding:"max=50,dive"`
+}
@@ -0,0 +1,87 @@
+package models
+
+import (
+	"time"
+)
+
+// Forecasting methods for projecting daily demand
+const (
+	ForecastMovingAverage = "moving_average"
+	ForecastExponential   = "exponential"
+)
+
+// DailyDemand is the stock a product lost on one day, taken from the
+// decreases in its history
+type DailyDemand struct {
+	ProductID uint
+	Day       time.Time
+	Quantity  Quantity
+}
+
+// ReplenishmentParams are the forecasting and reorder settings of a
+// replenishment report. Demand is forecast from the last WindowDays days;
+// Alpha weights recent days under exponential smoothing. Lead time and
+// safety stock are in days of forecast demand, and an order suggested for a
+// product brings its stock position up to its reorder level plus CoverDays
+// of demand. Days run midnight to midnight in Location.
+type ReplenishmentParams struct {
+	Method          string
+	WindowDays      int
+	Alpha           float64
+	LeadTimeDays    int
+	SafetyStockDays int
+	CoverDays       int
+	Location        *time.Location
+	CategoryID      *uint
+}
+
+// ReplenishmentLine is the DTO for one product's demand forecast and
+// suggested order. DaysOfCover is how long available stock lasts at the
+// forecast demand and is omitted when there is no demand. OnOrder is the
+// quantity still to be received on sent purchase orders, which counts
+// towards the stock position.
+type ReplenishmentLine struct {
+	ProductID         uint     `json:"product_id"`
+	SKU               string   `json:"sku"`
+	Name              string   `json:"name"`
+	CategoryID        uint     `json:"category_id,omitempty"`
+	BaseUnit          string   `json:"base_unit"`
+	Available         Quantity `json:"available" swaggertype:"number"`
+	OnOrder           Quantity `json:"on_order" swaggertype:"number"`
+	Position          Quantity `json:"position" swaggertype:"number"`
+	Demand            Quantity `json:"demand" swaggertype:"number"`
+	DailyDemand       Quantity `json:"daily_demand" swaggertype:"number"`
+	DaysOfCover       *float64 `json:"days_of_cover,omitempty"`
+	LeadTimeDemand    Quantity `json:"lead_time_demand" swaggertype:"number"`
+	SafetyStock       Quantity `json:"safety_stock" swaggertype:"number"`
+	ReorderLevel      Quantity `json:"reorder_level" swaggertype:"number"`
+	SuggestedQuantity Quantity `json:"suggested_quantity" swaggertype:"number"`
+}
+
+// ReplenishmentReport is the DTO for the replenishment report. Lines are
+// ordered by days of cover, most urgent first.
+type ReplenishmentReport struct {
+	Method          string              `json:"method"`
+	Alpha           float64             `json:"alpha,omitempty"`
+	From            time.Time           `json:"from"`
+	To              time.Time           `json:"to"`
+	WindowDays      int                 `json:"window_days"`
+	LeadTimeDays    int                 `json:"lead_time_days"`
+	SafetyStockDays int                 `json:"safety_stock_days"`
+	CoverDays       int                 `json:"cover_days"`
+	Lines           []ReplenishmentLine `json:"lines"`
+}
+
+// ReplenishmentQuery is the DTO for replenishment report query parameters.
+// Unset values default to the configured forecast settings; All lists every
+// product, not only those that need ordering.
+type ReplenishmentQuery struct {
+	Method          string  `form:"method" binding:"omitempty,oneof=moving_average exponential"`
+	WindowDays      int     `form:"window_days" binding:"omitempty,min=1,max=730"`
+	Alpha           float64 `form:"alpha" binding:"omitempty,gt=0,lte=1"`
+	LeadTimeDays    *int    `form:"lead_time_days" binding:"omitempty,min=0,max=365"`
+	SafetyStockDays *int    `form:"safety_stock_days" binding:"omitempty,min=0,max=365"`
+	CoverDays       *int    `form:"cover_days" binding:"omitempty,min=0,max=365"`
+	CategoryID      uint    `form:"category_id"`
+	All             bool    `form:"all"`
+}
@@ -0,0 +1,140 @@
+package models
+
+import (
+	"time"
+)
+
+// Lot is a batch of a lot-tracked product held at a warehouse. The lots of a
+// product at a warehouse account for its stock there, apart from any stock
+// that predates lot tracking.
+type Lot struct {
+	ID             uint       `gorm:"primaryKey" json:"id"`
+	ProductID      uint       `gorm:"not null;uniqueIndex:idx_lots_product_warehouse_number" json:"product_id"`
+	Product        Product    `gorm:"foreignKey:ProductID" json:"-"`
+	WarehouseID    uint       `gorm:"not null;uniqueIndex:idx_lots_product_warehouse_number;index" json:"warehouse_id"`
+	Warehouse      Warehouse  `gorm:"foreignKey:WarehouseID" json:"-"`
+	LotNumber      string     `gorm:"not null;size:50;uniqueIndex:idx_lots_product_warehouse_number" json:"lot_number"`
+	ManufacturedAt *time.Time `json:"manufactured_at,omitempty"`
+	ExpiresAt      *time.Time `gorm:"index" json:"expires_at,omitempty"`
+	Quantity       Quantity   `gorm:"not null;type:decimal(14,3);default:0" json:"quantity" swaggertype:"number"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// TableName specifies the table name for Lot model
+func (Lot) TableName() string {
+	return "lots"
+}
+
+// LotResponse is the DTO for lot responses
+type LotResponse struct {
+	ID             uint       `json:"id"`
+	ProductID      uint       `json:"product_id"`
+	ProductName    string     `json:"product_name,omitempty"`
+	SKU            string     `json:"sku,omitempty"`
+	WarehouseID    uint       `json:"warehouse_id"`
+	WarehouseCode  string     `json:"warehouse_code,omitempty"`
+	LotNumber      string     `json:"lot_number"`
+	ManufacturedAt *time.Time `json:"manufactured_at,omitempty"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	Quantity       Quantity   `json:"quantity" swaggertype:"number"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// ToResponse converts Lot to LotResponse
+func (l *Lot) ToResponse() LotResponse {
+	return LotResponse{
+		ID:             l.ID,
+		ProductID:      l.ProductID,
+		ProductName:    l.Product.Name,
+		SKU:            l.Product.SKU,
+		WarehouseID:    l.WarehouseID,
+		WarehouseCode:  l.Warehouse.Code,
+		LotNumber:      l.LotNumber,
+		ManufacturedAt: l.ManufacturedAt,
+		ExpiresAt:      l.ExpiresAt,
+		Quantity:       l.Quantity,
+		CreatedAt:      l.CreatedAt,
+		UpdatedAt:      l.UpdatedAt,
+	}
+}
+
+// StockMovementLot records how much of a movement went into or came out of
+// a lot. Quantity is signed like the movement's.
+type StockMovementLot struct {
+	ID         uint     `gorm:"primaryKey" json:"id"`
+	MovementID uint     `gorm:"not null;index" json:"movement_id"`
+	LotID      uint     `gorm:"not null;index" json:"lot_id"`
+	Lot        Lot      `gorm:"foreignKey:LotID" json:"-"`
+	Quantity   Quantity `gorm:"not null;type:decimal(14,3)" json:"quantity" swaggertype:"number"`
+}
+
+// TableName specifies the table name for StockMovementLot model
+func (StockMovementLot) TableName() string {
+	return "stock_movement_lots"
+}
+
+// StockMovementLotResponse is the DTO for the lots touched by a movement
+type StockMovementLotResponse struct {
+	LotID     uint       `json:"lot_id"`
+	LotNumber string     `json:"lot_number"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Quantity  Quantity   `json:"quantity" swaggertype:"number"`
+}
+
+// ToResponse converts StockMovementLot to StockMovementLotResponse
+func (l *StockMovementLot) ToResponse() StockMovementLotResponse {
+	return StockMovementLotResponse{
+		LotID:     l.LotID,
+		LotNumber: l.Lot.LotNumber,
+		ExpiresAt: l.Lot.ExpiresAt,
+		Quantity:  l.Quantity,
+	}
+}
+
+// LotInput identifies the lot that inbound stock belongs to
+type LotInput struct {
+	LotNumber      string     `json:"lot_number" binding:"omitempty,max=50"`
+	ManufacturedAt *time.Time `json:"manufactured_at"`
+	ExpiresAt      *time.Time `json:"expires_at"`
+}
+
+// Entries returns the inbound lot entry for quantity, or nil when no lot
+// number was given
+func (in LotInput) Entries(quantity Quantity) []StockMovementLot {
+	if in.LotNumber == "" {
+		return nil
+	}
+	return []StockMovementLot{{
+		Lot: Lot{
+			LotNumber:      in.LotNumber,
+			ManufacturedAt: in.ManufacturedAt,
+			ExpiresAt:      in.ExpiresAt,
+		},
+		Quantity: quantity,
+	}}
+}
+
+// LotListQuery is the DTO for listing a product's lots
+type LotListQuery struct {
+	PaginationRequest
+	WarehouseID  uint `form:"warehouse_id"`
+	IncludeEmpty bool `form:"include_empty"`
+}
+
+// ExpiringLotQuery is the DTO for finding lots that expire within Days days
+// (default 30). Lots that have already expired are included.
+type ExpiringLotQuery struct {
+	PaginationRequest
+	Days        *int `form:"days" binding:"omitempty,min=0,max=3650"`
+	WarehouseID uint `form:"warehouse_id"`
+}
+
+// GetDays returns the look-ahead window in days with default
+func (q *ExpiringLotQuery) GetDays() int {
+	if q.Days == nil {
+		return 30
+	}
+	return *q.Days
+}
@@ -0,0 +1,279 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DefaultCurrency is the ISO 4217 currency of prices given without one
+const DefaultCurrency = "USD"
+
+var (
+	ErrInvalidAmount = errors.New("amount must be a decimal number with at most two decimal places")
+	ErrInvalidCost   = errors.New("cost must be a decimal number with at most four decimal places")
+)
+
+// Amount is an exact amount of money in hundredths of a currency unit, the
+// scale of the decimal(10,2) columns money is stored in. It is read and
+// written as a decimal number, never through a float, so totals do not drift.
+type Amount int64
+
+// ParseAmount parses a decimal number such as "12.34" into an Amount. It
+// rejects values with non-zero digits past the second decimal place.
+func ParseAmount(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		return 0, ErrInvalidAmount
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return 0, ErrInvalidAmount
+	}
+	r.Mul(r, big.NewRat(100, 1))
+	if !r.IsInt() || !r.Num().IsInt64() {
+		return 0, ErrInvalidAmount
+	}
+	return Amount(r.Num().Int64()), nil
+}
+
+// String renders the amount with exactly two decimal places
+func (a Amount) String() string {
+	sign := ""
+	n := int64(a)
+	if n < 0 {
+		sign = "-"
+		n = -n
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, n/100, n%100)
+}
+
+// Mul returns the amount for a quantity, rounded half away from zero to the
+// hundredth. Quantities are exact to MaxQuantityPrecision decimal places, so
+// the product is taken in integers.
+func (a Amount) Mul(quantity Quantity) Amount {
+	milli := big.NewInt(int64(quantity))
+	total := new(big.Rat).SetFrac(milli.Mul(milli, big.NewInt(int64(a))), big.NewInt(1000))
+	rounded, _ := total.Float64()
+	return Amount(math.Round(rounded))
+}
+
+// Scaled returns the amount for quantity units when a is the amount for per
+// units, such as the price of 40 screws from the price of a box of 100,
+// rounded half away from zero to the hundredth. Both are exact to
+// MaxQuantityPrecision decimal places, so the ratio is taken in integers.
+func (a Amount) Scaled(quantity, per Quantity) Amount {
+	if per <= 0 || per == Units(1) {
+		return a.Mul(quantity)
+	}
+	milli := big.NewInt(int64(quantity))
+	total := new(big.Rat).SetFrac(milli.Mul(milli, big.NewInt(int64(a))), big.NewInt(int64(per)))
+	rounded, _ := total.Float64()
+	return Amount(math.Round(rounded))
+}
+
+// Cost returns the amount as a Cost
+func (a Amount) Cost() Cost {
+	return Cost(a * 100)
+}
+
+// Percent returns rate percent of the amount, rounded half away from zero to
+// the hundredth. Rates are exact to four decimal places.
+func (a Amount) Percent(rate float64) Amount {
+	tenThousandths := big.NewInt(int64(math.Round(rate * 10000)))
+	total := new(big.Rat).SetFrac(tenThousandths.Mul(tenThousandths, big.NewInt(int64(a))), big.NewInt(1000000))
+	rounded, _ := total.Float64()
+	return Amount(math.Round(rounded))
+}
+
+// Value stores the amount as a decimal string so the column keeps it exactly
+func (a Amount) Value() (driver.Value, error) {
+	return a.String(), nil
+}
+
+// Scan reads a decimal column into the amount
+func (a *Amount) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*a = 0
+		return nil
+	case string:
+		return a.parse(v)
+	case []byte:
+		return a.parse(string(v))
+	case int64:
+		*a = Amount(v * 100)
+		return nil
+	case float64:
+		return a.parse(strconv.FormatFloat(v, 'f', 2, 64))
+	default:
+		return fmt.Errorf("cannot scan %T into Amount", src)
+	}
+}
+
+// MarshalJSON writes the amount as a JSON number with two decimal places
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return []byte(a.String()), nil
+}
+
+// UnmarshalJSON reads the amount from a JSON number or a quoted decimal
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	return a.parse(s)
+}
+
+func (a *Amount) parse(s string) error {
+	parsed, err := ParseAmount(s)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// Cost is an exact unit cost in ten-thousandths of a currency unit, the
+// scale of the decimal(12,4) columns costs are stored in. Costs are in the
+// currency of the product they belong to. Like Amount, it is read and
+// written as a decimal number, never through a float.
+type Cost int64
+
+// ParseCost parses a decimal number such as "0.0349" into a Cost. It
+// rejects values with non-zero digits past the fourth decimal place.
+func ParseCost(s string) (Cost, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		return 0, ErrInvalidCost
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return 0, ErrInvalidCost
+	}
+	r.Mul(r, big.NewRat(10000, 1))
+	if !r.IsInt() || !r.Num().IsInt64() {
+		return 0, ErrInvalidCost
+	}
+	return Cost(r.Num().Int64()), nil
+}
+
+// String renders the cost with exactly four decimal places
+func (c Cost) String() string {
+	sign := ""
+	n := int64(c)
+	if n < 0 {
+		sign = "-"
+		n = -n
+	}
+	return fmt.Sprintf("%s%d.%04d", sign, n/10000, n%10000)
+}
+
+// Mul returns the cost of a quantity, rounded half away from zero to the
+// ten-thousandth
+func (c Cost) Mul(quantity Quantity) Cost {
+	milli := big.NewInt(int64(quantity))
+	total := new(big.Rat).SetFrac(milli.Mul(milli, big.NewInt(int64(c))), big.NewInt(1000))
+	rounded, _ := total.Float64()
+	return Cost(math.Round(rounded))
+}
+
+// Div returns the cost of one unit when c is the cost of quantity units,
+// rounded half away from zero to the ten-thousandth. A quantity of zero or
+// less leaves the cost unchanged.
+func (c Cost) Div(quantity Quantity) Cost {
+	if quantity <= 0 {
+		return c
+	}
+	total := new(big.Rat).SetFrac(big.NewInt(int64(c)*1000), big.NewInt(int64(quantity)))
+	rounded, _ := total.Float64()
+	return Cost(math.Round(rounded))
+}
+
+// Amount returns the cost rounded half away from zero to the hundredth, for
+// stock values reported as money
+func (c Cost) Amount() Amount {
+	n := int64(c)
+	whole, rest := n/100, n%100
+	switch {
+	case rest >= 50:
+		whole++
+	case rest <= -50:
+		whole--
+	}
+	return Amount(whole)
+}
+
+// WeightedCost returns the average of two unit costs weighted by the
+// quantities held at each, rounded half away from zero to the
+// ten-thousandth. It returns b if the quantities add up to zero or less.
+func WeightedCost(a Cost, aQuantity Quantity, b Cost, bQuantity Quantity) Cost {
+	aMilli := big.NewInt(int64(aQuantity))
+	bMilli := big.NewInt(int64(bQuantity))
+	held := new(big.Int).Add(aMilli, bMilli)
+	if held.Sign() <= 0 {
+		return b
+	}
+	total := aMilli.Mul(aMilli, big.NewInt(int64(a)))
+	total.Add(total, bMilli.Mul(bMilli, big.NewInt(int64(b))))
+	rounded, _ := new(big.Rat).SetFrac(total, held).Float64()
+	return Cost(math.Round(rounded))
+}
+
+// Value stores the cost as a decimal string so the column keeps it exactly
+func (c Cost) Value() (driver.Value, error) {
+	return c.String(), nil
+}
+
+// Scan reads a decimal column into the cost
+func (c *Cost) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*c = 0
+		return nil
+	case string:
+		return c.parse(v)
+	case []byte:
+		return c.parse(string(v))
+	case int64:
+		*c = Cost(v * 10000)
+		return nil
+	case float64:
+		return c.parse(strconv.FormatFloat(v, 'f', 4, 64))
+	default:
+		return fmt.Errorf("cannot scan %T into Cost", src)
+	}
+}
+
+// MarshalJSON writes the cost as a JSON number with four decimal places
+func (c Cost) MarshalJSON() ([]byte, error) {
+	return []byte(c.String()), nil
+}
+
+// UnmarshalJSON reads the cost from a JSON number or a quoted decimal
+func (c *Cost) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	return c.parse(s)
+}
+
+func (c *Cost) parse(s string) error {
+	parsed, err := ParseCost(s)
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
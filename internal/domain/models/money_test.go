@@ -0,0 +1,203 @@
+package models
+
+import "testing"
+
+func TestAmountScaled(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   Amount
+		quantity Quantity
+		per      Quantity
+		want     Amount
+	}{
+		{"base unit", 349, 3000, 1000, 1047},
+		{"whole boxes", 349, 300000, 100000, 1047},
+		{"part of a box", 349, 40000, 100000, 140},
+		{"one of a box", 349, 1000, 100000, 3},
+		{"rounds half away from zero", 350, 1000, 4000, 88},
+		{"negative rounds away from zero", -350, 1000, 4000, -88},
+		{"fractional quantity", 1000, 500, 3000, 167},
+		{"fractional per", 200, 1000, 250, 800},
+		{"no per", 349, 2000, 0, 698},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.amount.Scaled(tt.quantity, tt.per); got != tt.want {
+				t.Errorf("Amount(%d).Scaled(%v, %v) = %d, want %d", tt.amount, tt.quantity, tt.per, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Amount
+		wantErr bool
+	}{
+		{"12.34", 1234, false},
+		{"12", 1200, false},
+		{"0.5", 50, false},
+		{" 7.10 ", 710, false},
+		{"-3.25", -325, false},
+		{"1.230", 123, false},
+		{"1e2", 10000, false},
+		{"1.234", 0, true},
+		{"1/2", 0, true},
+		{"abc", 0, true},
+		{"", 0, true},
+		{"99999999999999999999", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseAmount(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseAmount(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseAmount(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAmountMul(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   Amount
+		quantity Quantity
+		want     Amount
+	}{
+		{"whole quantity", 1999, 3000, 5997},
+		{"fractional quantity", 1999, 500, 1000},
+		{"milli quantity", 1000, 1, 1},
+		{"sum of fractions", 10, 100 + 200, 3},
+		{"rounds half away from zero", 5, 500, 3},
+		{"negative rounds away from zero", -5, 500, -3},
+		{"zero quantity", 1999, 0, 0},
+		{"large total", 99999999, 1000000, 99999999000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.amount.Mul(tt.quantity); got != tt.want {
+				t.Errorf("Amount(%d).Mul(%v) = %d, want %d", tt.amount, tt.quantity, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAmountPercent(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount Amount
+		rate   float64
+		want   Amount
+	}{
+		{"whole rate", 10000, 20, 2000},
+		{"fractional rate", 1999, 8.25, 165},
+		{"four decimal rate", 100000, 7.1234, 7123},
+		{"rounds half away from zero", 50, 1, 1},
+		{"negative rounds away from zero", -50, 1, -1},
+		{"zero rate", 1999, 0, 0},
+		{"full rate", 1999, 100, 1999},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.amount.Percent(tt.rate); got != tt.want {
+				t.Errorf("Amount(%d).Percent(%v) = %d, want %d", tt.amount, tt.rate, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCost(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Cost
+		wantErr bool
+	}{
+		{"0.0349", 349, false},
+		{"3.49", 34900, false},
+		{"2", 20000, false},
+		{"-1.5", -15000, false},
+		{"0.00001", 0, true},
+		{"1/3", 0, true},
+		{"cost", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseCost(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCost(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseCost(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCostArithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Cost
+		want Cost
+	}{
+		{"mul", Cost(349).Mul(100000), 34900},
+		{"mul rounds half away from zero", Cost(5).Mul(500), 3},
+		{"mul fractional quantity", Cost(33333).Mul(333), 11100},
+		{"div", Cost(34900).Div(100000), 349},
+		{"div rounds half away from zero", Cost(4).Div(8000), 1},
+		{"div by a fraction", Cost(10000).Div(250), 40000},
+		{"div by zero leaves the cost", Cost(10000).Div(0), 10000},
+		{"weighted", WeightedCost(20000, 10000, 30000, 10000), 25000},
+		{"weighted rounds", WeightedCost(20000, 2000, 25000, 1000), 21667},
+		{"weighted from nothing held", WeightedCost(20000, 0, 30000, 5000), 30000},
+		{"weighted with nothing in total", WeightedCost(20000, 0, 30000, 0), 30000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %d, want %d", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCostAmount(t *testing.T) {
+	tests := []struct {
+		cost Cost
+		want Amount
+	}{
+		{34900, 349},
+		{349, 3},
+		{350, 4},
+		{349999, 3500},
+		{-350, -4},
+		{-349, -3},
+		{0, 0},
+	}
+	for _, tt := range tests {
+		if got := tt.cost.Amount(); got != tt.want {
+			t.Errorf("Cost(%d).Amount() = %d, want %d", tt.cost, got, tt.want)
+		}
+	}
+}
+
+func TestAddCurrencyValue(t *testing.T) {
+	var values []CurrencyValue
+	values = AddCurrencyValue(values, "USD", 100)
+	values = AddCurrencyValue(values, "EUR", 250)
+	values = AddCurrencyValue(values, "USD", 50)
+	values = AddCurrencyValue(values, "GBP", 10)
+
+	want := []CurrencyValue{{"EUR", 250}, {"GBP", 10}, {"USD", 150}}
+	if len(values) != len(want) {
+		t.Fatalf("AddCurrencyValue() = %v, want %v", values, want)
+	}
+	for i := range want {
+		if values[i] != want[i] {
+			t.Errorf("AddCurrencyValue()[%d] = %v, want %v", i, values[i], want[i])
+		}
+	}
+}
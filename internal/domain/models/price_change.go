@@ -0,0 +1,100 @@
+package models
+
+import (
+	"time"
+)
+
+// PriceChangeStatus is the lifecycle state of a scheduled price change
+type PriceChangeStatus string
+
+const (
+	PriceChangePending   PriceChangeStatus = "pending"
+	PriceChangeActive    PriceChangeStatus = "active"
+	PriceChangeCompleted PriceChangeStatus = "completed"
+	PriceChangeCancelled PriceChangeStatus = "cancelled"
+	PriceChangeExpired   PriceChangeStatus = "expired"
+)
+
+// ScheduledPriceChange sets a product's price at StartsAt. A change with an
+// EndsAt is a promotion: the price it replaced is restored when it ends.
+// A permanent change completes as soon as it is applied.
+type ScheduledPriceChange struct {
+	ID            uint              `gorm:"primaryKey" json:"id"`
+	ProductID     uint              `gorm:"not null;index" json:"product_id"`
+	Product       Product           `gorm:"foreignKey:ProductID" json:"-"`
+	Price         Amount            `gorm:"not null;type:decimal(10,2)" json:"price" swaggertype:"number"`
+	PreviousPrice *Amount           `gorm:"type:decimal(10,2)" json:"previous_price,omitempty" swaggertype:"number"`
+	StartsAt      time.Time         `gorm:"not null;index" json:"starts_at"`
+	EndsAt        *time.Time        `gorm:"index" json:"ends_at,omitempty"`
+	Status        PriceChangeStatus `gorm:"type:varchar(20);not null;default:'pending';index" json:"status"`
+	Note          string            `gorm:"size:255" json:"note"`
+	AppliedAt     *time.Time        `json:"applied_at,omitempty"`
+	EndedAt       *time.Time        `json:"ended_at,omitempty"`
+	UserID        *uint             `gorm:"index" json:"user_id,omitempty"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+}
+
+// TableName specifies the table name for ScheduledPriceChange model
+func (ScheduledPriceChange) TableName() string {
+	return "scheduled_price_changes"
+}
+
+// IsPromotion reports whether the change ends and restores the earlier price
+func (c *ScheduledPriceChange) IsPromotion() bool {
+	return c.EndsAt != nil
+}
+
+// ScheduledPriceChangeResponse is the DTO for scheduled price change responses
+type ScheduledPriceChangeResponse struct {
+	ID            uint              `json:"id"`
+	ProductID     uint              `json:"product_id"`
+	Price         Amount            `json:"price" swaggertype:"number"`
+	PreviousPrice *Amount           `json:"previous_price,omitempty" swaggertype:"number"`
+	StartsAt      time.Time         `json:"starts_at"`
+	EndsAt        *time.Time        `json:"ends_at,omitempty"`
+	Status        PriceChangeStatus `json:"status"`
+	Note          string            `json:"note"`
+	AppliedAt     *time.Time        `json:"applied_at,omitempty"`
+	EndedAt       *time.Time        `json:"ended_at,omitempty"`
+	UserID        *uint             `json:"user_id,omitempty"`
+	CreatedAt     time.Time         `json:"created_at"`
+	UpdatedAt     time.Time         `json:"updated_at"`
+}
+
+// ToResponse converts ScheduledPriceChange to ScheduledPriceChangeResponse
+func (c *ScheduledPriceChange) ToResponse() ScheduledPriceChangeResponse {
+	return ScheduledPriceChangeResponse{
+		ID:            c.ID,
+		ProductID:     c.ProductID,
+		Price:         c.Price,
+		PreviousPrice: c.PreviousPrice,
+		StartsAt:      c.StartsAt,
+		EndsAt:        c.EndsAt,
+		Status:        c.Status,
+		Note:          c.Note,
+		AppliedAt:     c.AppliedAt,
+		EndedAt:       c.EndedAt,
+		UserID:        c.UserID,
+		CreatedAt:     c.CreatedAt,
+		UpdatedAt:     c.UpdatedAt,
+	}
+}
+
+// CreatePriceChangeRequest is the DTO for scheduling a price change. Price is
+// in the product's currency. StartsAt must be in the future; EndsAt, if
+// given, makes the change a promotion and must be after StartsAt.
+type CreatePriceChangeRequest struct {
+	Price    Amount     `json:"price" binding:"required,gt=0" swaggertype:"number"`
+	StartsAt time.Time  `json:"starts_at" binding:"required"`
+	EndsAt   *time.Time `json:"ends_at"`
+	Note     string     `json:"note" binding:"max=255"`
+}
+
+// PriceChangeListQuery is the DTO for scheduled price change listing query
+// parameters. Without a status, the upcoming changes are listed: those
+// pending or active.
+type PriceChangeListQuery struct {
+	PaginationRequest
+	Status string `form:"status" binding:"omitempty,oneof=pending active completed cancelled expired"`
+}
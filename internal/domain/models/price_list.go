@@ -0,0 +1,182 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Price sources of a resolved price
+const (
+	PriceSourceList    = "price_list"
+	PriceSourceProduct = "product"
+)
+
+// PriceList is a named set of prices for a group of customers, such as
+// wholesale or retail. A product may have several prices on a list, each
+// applying from a minimum quantity, to give quantity breaks.
+type PriceList struct {
+	ID          uint             `gorm:"primaryKey" json:"id"`
+	Code        string           `gorm:"uniqueIndex;not null;size:50" json:"code"`
+	Name        string           `gorm:"not null;size:200" json:"name"`
+	Description string           `gorm:"size:500" json:"description"`
+	Currency    string           `gorm:"not null;size:3;default:'USD'" json:"currency"`
+	Prices      []PriceListPrice `gorm:"foreignKey:PriceListID" json:"prices,omitempty"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt   `gorm:"index" json:"-"`
+}
+
+// TableName specifies the table name for PriceList model
+func (PriceList) TableName() string {
+	return "price_lists"
+}
+
+// PriceListPrice is a product's price per base unit on a price list for
+// quantities of at least MinQuantity
+type PriceListPrice struct {
+	ID          uint     `gorm:"primaryKey" json:"id"`
+	PriceListID uint     `gorm:"not null;uniqueIndex:idx_price_list_prices_tier" json:"price_list_id"`
+	ProductID   uint     `gorm:"not null;index;uniqueIndex:idx_price_list_prices_tier" json:"product_id"`
+	Product     Product  `gorm:"foreignKey:ProductID" json:"-"`
+	MinQuantity Quantity `gorm:"not null;type:decimal(14,3);default:0;uniqueIndex:idx_price_list_prices_tier" json:"min_quantity" swaggertype:"number"`
+	Price       Amount   `gorm:"not null;type:decimal(10,2)" json:"price" swaggertype:"number"`
+}
+
+// TableName specifies the table name for PriceListPrice model
+func (PriceListPrice) TableName() string {
+	return "price_list_prices"
+}
+
+// PriceListResponse is the DTO for price list responses. Prices are only
+// included for a single price list.
+type PriceListResponse struct {
+	ID          uint                     `json:"id"`
+	Code        string                   `json:"code"`
+	Name        string                   `json:"name"`
+	Description string                   `json:"description"`
+	Currency    string                   `json:"currency"`
+	Prices      []PriceListPriceResponse `json:"prices,omitempty"`
+	CreatedAt   time.Time                `json:"created_at"`
+	UpdatedAt   time.Time                `json:"updated_at"`
+}
+
+// PriceListPriceResponse is the DTO for a price on a price list
+type PriceListPriceResponse struct {
+	ProductID   uint     `json:"product_id"`
+	ProductName string   `json:"product_name,omitempty"`
+	SKU         string   `json:"sku,omitempty"`
+	MinQuantity Quantity `json:"min_quantity" swaggertype:"number"`
+	Price       Amount   `json:"price" swaggertype:"number"`
+}
+
+// ToResponse converts PriceList to PriceListResponse
+func (l *PriceList) ToResponse() PriceListResponse {
+	response := PriceListResponse{
+		ID:          l.ID,
+		Code:        l.Code,
+		Name:        l.Name,
+		Description: l.Description,
+		Currency:    l.Currency,
+		CreatedAt:   l.CreatedAt,
+		UpdatedAt:   l.UpdatedAt,
+	}
+
+	for _, price := range l.Prices {
+		response.Prices = append(response.Prices, PriceListPriceResponse{
+			ProductID:   price.ProductID,
+			ProductName: price.Product.Name,
+			SKU:         price.Product.SKU,
+			MinQuantity: price.MinQuantity,
+			Price:       price.Price,
+		})
+	}
+
+	return response
+}
+
+// ResolvedPrice is the DTO for the price a product sells at for a quantity.
+// Source is price_list when a price on the list applies, or product when
+// the product's own price is used.
+type ResolvedPrice struct {
+	ProductID   uint     `json:"product_id"`
+	PriceList   string   `json:"price_list,omitempty"`
+	Source      string   `json:"source"`
+	Quantity    Quantity `json:"quantity" swaggertype:"number"`
+	MinQuantity Quantity `json:"min_quantity" swaggertype:"number"`
+	UnitPrice   Amount   `json:"unit_price" swaggertype:"number"`
+	Total       Amount   `json:"total" swaggertype:"number"`
+	Currency    string   `json:"currency"`
+}
+
+// ResolvePrice returns the price of quantity base units of the product. The
+// list price applies when one is given, and the product's own price
+// otherwise.
+func (p *Product) ResolvePrice(list *PriceList, price *PriceListPrice, quantity Quantity) ResolvedPrice {
+	resolved := ResolvedPrice{
+		ProductID: p.ID,
+		Source:    PriceSourceProduct,
+		Quantity:  quantity,
+		UnitPrice: p.Price,
+		Currency:  p.Currency,
+	}
+	if list != nil {
+		resolved.PriceList = list.Code
+		if price != nil {
+			resolved.Source = PriceSourceList
+			resolved.MinQuantity = price.MinQuantity
+			resolved.UnitPrice = price.Price
+			resolved.Currency = list.Currency
+		}
+	}
+	resolved.Total = resolved.UnitPrice.Mul(quantity)
+	return resolved
+}
+
+// CreatePriceListRequest is the DTO for creating a price list. Currency is
+// an ISO 4217 code and defaults to DefaultCurrency.
+type CreatePriceListRequest struct {
+	Code        string `json:"code" binding:"required,min=1,max=50"`
+	Name        string `json:"name" binding:"required,min=1,max=200"`
+	Description string `json:"description" binding:"max=500"`
+	Currency    string `json:"currency" binding:"omitempty,iso4217"`
+}
+
+// UpdatePriceListRequest is the DTO for updating a price list
+type UpdatePriceListRequest struct {
+	Code        string `json:"code" binding:"omitempty,min=1,max=50"`
+	Name        string `json:"name" binding:"omitempty,min=1,max=200"`
+	Description string `json:"description" binding:"max=500"`
+	Currency    string `json:"currency" binding:"omitempty,iso4217"`
+}
+
+// PriceListPriceRequest is the DTO for a price on a price list. Price is
+// per base unit and applies from MinQuantity base units.
+type PriceListPriceRequest struct {
+	ProductID   uint     `json:"product_id" binding:"required"`
+	MinQuantity Quantity `json:"min_quantity" binding:"gte=0" swaggertype:"number"`
+	Price       Amount   `json:"price" binding:"required,gt=0" swaggertype:"number"`
+}
+
+// SetPriceListPricesRequest is the DTO for replacing a price list's prices
+type SetPriceListPricesRequest struct {
+	Prices []PriceListPriceRequest `json:"prices" binding:"max=5000,dive"`
+}
+
+// PriceListQuery is the DTO for price list listing query parameters
+type PriceListQuery struct {
+	PaginationRequest
+	Search string `form:"search"`
+}
+
+// ResolvePriceQuery is the DTO for price resolution query parameters. List
+// is a price list code; Quantity is in base units and defaults to 1.
+type ResolvePriceQuery struct {
+	List     string  `form:"list" binding:"max=50"`
+	Quantity float64 `form:"qty" binding:"omitempty,gt=0"`
+}
+
+// GetQuantity returns the quantity to price, 1 if none was given
+func (q *ResolvePriceQuery) GetQuantity() (Quantity, error) {
+	return QueryQuantity(q.Quantity)
+}
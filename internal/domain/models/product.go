@@ -7,18 +7,45 @@ import (
 )
 
 type Product struct {
-	ID          uint           `gorm:"primaryKey" json:"id"`
-	Name        string         `gorm:"not null;size:200" json:"name"`
-	Description string         `gorm:"size:1000" json:"description"`
-	SKU         string         `gorm:"uniqueIndex;not null;size:50" json:"sku"`
-	Stock       int            `gorm:"not null;default:0" json:"stock"`
-	Price       float64        `gorm:"not null;type:decimal(10,2)" json:"price"`
-	CategoryID  uint           `gorm:"index" json:"category_id,omitempty"`
-	Category    Category       `gorm:"foreignKey:CategoryID" json:"category,omitempty"`
-	Categories  []Category     `gorm:"many2many:product_categories;" json:"categories,omitempty"`
-	CreatedAt   time.Time      `json:"created_at"`
-	UpdatedAt   time.Time      `json:"updated_at"`
-	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+	ID                uint           `gorm:"primaryKey" json:"id"`
+	Name              string         `gorm:"not null;size:200" json:"name"`
+	Description       string         `gorm:"size:1000" json:"description"`
+	SKU               string         `gorm:"uniqueIndex;not null;size:50" json:"sku"`
+	Stock             Quantity       `gorm:"not null;type:decimal(14,3);default:0" json:"stock" swaggertype:"number"`
+	Reserved          Quantity       `gorm:"not null;type:decimal(14,3);default:0" json:"reserved" swaggertype:"number"`
+	ReorderPoint      Quantity       `gorm:"not null;type:decimal(14,3);default:0" json:"reorder_point" swaggertype:"number"`
+	ReorderQuantity   Quantity       `gorm:"not null;type:decimal(14,3);default:0" json:"reorder_quantity" swaggertype:"number"`
+	LotTracked        bool           `gorm:"not null;default:false" json:"lot_tracked"`
+	Serialized        bool           `gorm:"not null;default:false" json:"serialized"`
+	Price             Amount         `gorm:"not null;type:decimal(10,2)" json:"price" swaggertype:"number"`
+	Currency          string         `gorm:"not null;size:3;default:'USD'" json:"currency"`
+	AverageCost       Cost           `gorm:"not null;type:decimal(12,4);default:0" json:"average_cost" swaggertype:"number"`
+	BaseUnit          string         `gorm:"not null;size:20;default:'each'" json:"base_unit"`
+	QuantityPrecision int            `gorm:"not null;default:0" json:"quantity_precision"`
+	TaxClassID        *uint          `gorm:"index" json:"tax_class_id,omitempty"`
+	Units             []ProductUnit  `gorm:"foreignKey:ProductID" json:"units,omitempty"`
+	CategoryID        uint           `gorm:"index" json:"category_id,omitempty"`
+	Category          Category       `gorm:"foreignKey:CategoryID" json:"category,omitempty"`
+	Categories        []Category     `gorm:"many2many:product_categories;" json:"categories,omitempty"`
+	Stocks            []ProductStock `gorm:"foreignKey:ProductID" json:"stocks,omitempty"`
+	CreatedAt         time.Time      `json:"created_at"`
+	UpdatedAt         time.Time      `json:"updated_at"`
+	DeletedAt         gorm.DeletedAt `gorm:"index" json:"-"`
+
+	// ParentID is set on variants. A parent holds the option axes and its
+	// variants each carry one value per axis.
+	ParentID     *uint                `gorm:"index" json:"parent_id,omitempty"`
+	Options      []ProductOption      `gorm:"foreignKey:ProductID" json:"options,omitempty"`
+	OptionValues []ProductOptionValue `gorm:"many2many:product_variant_values;joinForeignKey:ProductID;joinReferences:OptionValueID" json:"option_values,omitempty"`
+
+	// Components is the bill of materials of a bundle. Bundles hold no
+	// stock of their own; their stock is made up of their components'.
+	Components []BundleComponent `gorm:"foreignKey:BundleID" json:"components,omitempty"`
+
+	// Totals over a parent's variants, loaded with the product
+	VariantCount     int      `gorm:"-" json:"-"`
+	VariantStock     Quantity `gorm:"-" json:"-"`
+	VariantAvailable Quantity `gorm:"-" json:"-"`
 }
 
 // TableName specifies the table name for Product model
@@ -26,33 +53,103 @@ func (Product) TableName() string {
 	return "products"
 }
 
+// Available returns the stock that is not held by reservations
+func (p *Product) Available() Quantity {
+	if p.Reserved >= p.Stock {
+		return 0
+	}
+	return p.Stock - p.Reserved
+}
+
+// IsBundle reports whether the product is a bundle. Components must be loaded.
+func (p *Product) IsBundle() bool {
+	return len(p.Components) > 0
+}
+
+// BundleAvailability returns how many complete bundles the components'
+// stock and available stock make up. Components must be loaded.
+func (p *Product) BundleAvailability() (stock, available Quantity) {
+	for i, component := range p.Components {
+		componentStock := component.Component.Stock.Whole(component.Quantity)
+		componentAvailable := component.Component.Available().Whole(component.Quantity)
+		if i == 0 || componentStock < stock {
+			stock = componentStock
+		}
+		if i == 0 || componentAvailable < available {
+			available = componentAvailable
+		}
+	}
+	return stock, available
+}
+
 // ProductResponse is the DTO for product responses
 type ProductResponse struct {
-	ID          uint               `json:"id"`
-	Name        string             `json:"name"`
-	Description string             `json:"description"`
-	SKU         string             `json:"sku"`
-	Stock       int                `json:"stock"`
-	Price       float64            `json:"price"`
-	CategoryID  uint               `json:"category_id,omitempty"`
-	Category    CategoryResponse   `json:"category,omitempty"`
-	Categories  []CategoryResponse `json:"categories,omitempty"`
-	CreatedAt   time.Time          `json:"created_at"`
-	UpdatedAt   time.Time          `json:"updated_at"`
+	ID                uint                   `json:"id"`
+	Name              string                 `json:"name"`
+	Description       string                 `json:"description"`
+	SKU               string                 `json:"sku"`
+	Stock             Quantity               `json:"stock" swaggertype:"number"`
+	Reserved          Quantity               `json:"reserved" swaggertype:"number"`
+	Available         Quantity               `json:"available" swaggertype:"number"`
+	ReorderPoint      Quantity               `json:"reorder_point" swaggertype:"number"`
+	ReorderQuantity   Quantity               `json:"reorder_quantity" swaggertype:"number"`
+	LotTracked        bool                   `json:"lot_tracked"`
+	Serialized        bool                   `json:"serialized"`
+	Price             Amount                 `json:"price" swaggertype:"number"`
+	Currency          string                 `json:"currency"`
+	AverageCost       Cost                   `json:"average_cost" swaggertype:"number"`
+	BaseUnit          string                 `json:"base_unit"`
+	QuantityPrecision int                    `json:"quantity_precision"`
+	Units             []ProductUnitResponse  `json:"units,omitempty"`
+	InUnit            *ProductUnitQuantities `json:"in_unit,omitempty"`
+	ResolvedPrice     *ResolvedPrice         `json:"resolved_price,omitempty"`
+	TaxClassID        *uint                  `json:"tax_class_id,omitempty"`
+	Tax               *ProductTax            `json:"tax,omitempty"`
+	CategoryID        uint                   `json:"category_id,omitempty"`
+	Category          CategoryResponse       `json:"category,omitempty"`
+	Categories        []CategoryResponse     `json:"categories,omitempty"`
+	Locations         []ProductStockResponse `json:"locations,omitempty"`
+	CreatedAt         time.Time              `json:"created_at"`
+	UpdatedAt         time.Time              `json:"updated_at"`
+
+	// Variant fields: parents list their option axes and the totals of their
+	// own stock plus their variants'; variants list their option values
+	ParentID           *uint                   `json:"parent_id,omitempty"`
+	Options            []ProductOptionResponse `json:"options,omitempty"`
+	OptionValues       map[string]string       `json:"option_values,omitempty"`
+	VariantCount       int                     `json:"variant_count,omitempty"`
+	AggregateStock     *Quantity               `json:"aggregate_stock,omitempty" swaggertype:"number"`
+	AggregateAvailable *Quantity               `json:"aggregate_available,omitempty" swaggertype:"number"`
+
+	// Bundles report the stock their components make up
+	IsBundle   bool                      `json:"is_bundle"`
+	Components []BundleComponentResponse `json:"components,omitempty"`
 }
 
 // ToResponse converts Product to ProductResponse
 func (p *Product) ToResponse() ProductResponse {
 	resp := ProductResponse{
-		ID:          p.ID,
-		Name:        p.Name,
-		Description: p.Description,
-		SKU:         p.SKU,
-		Stock:       p.Stock,
-		Price:       p.Price,
-		CategoryID:  p.CategoryID,
-		CreatedAt:   p.CreatedAt,
-		UpdatedAt:   p.UpdatedAt,
+		ID:                p.ID,
+		Name:              p.Name,
+		Description:       p.Description,
+		SKU:               p.SKU,
+		Stock:             p.Stock,
+		Reserved:          p.Reserved,
+		Available:         p.Available(),
+		ReorderPoint:      p.ReorderPoint,
+		ReorderQuantity:   p.ReorderQuantity,
+		LotTracked:        p.LotTracked,
+		Serialized:        p.Serialized,
+		Price:             p.Price,
+		Currency:          p.Currency,
+		AverageCost:       p.AverageCost,
+		BaseUnit:          p.BaseUnit,
+		QuantityPrecision: p.QuantityPrecision,
+		TaxClassID:        p.TaxClassID,
+		CategoryID:        p.CategoryID,
+		CreatedAt:         p.CreatedAt,
+		UpdatedAt:         p.UpdatedAt,
+		ParentID:          p.ParentID,
 	}
 	if p.Category.ID != 0 {
 		resp.Category = p.Category.ToResponse()
@@ -63,33 +160,96 @@ func (p *Product) ToResponse() ProductResponse {
 			resp.Categories[i] = cat.ToResponse()
 		}
 	}
+	if len(p.Units) > 0 {
+		resp.Units = make([]ProductUnitResponse, len(p.Units))
+		for i, unit := range p.Units {
+			resp.Units[i] = ProductUnitResponse{Name: unit.Name, Factor: unit.Factor}
+		}
+	}
+	if len(p.Stocks) > 0 {
+		resp.Locations = make([]ProductStockResponse, len(p.Stocks))
+		for i, stock := range p.Stocks {
+			resp.Locations[i] = stock.ToResponse()
+		}
+	}
+	if len(p.Options) > 0 {
+		resp.Options = make([]ProductOptionResponse, len(p.Options))
+		for i, option := range p.Options {
+			resp.Options[i] = option.ToResponse()
+		}
+	}
+	if len(p.OptionValues) > 0 {
+		resp.OptionValues = make(map[string]string, len(p.OptionValues))
+		for _, value := range p.OptionValues {
+			resp.OptionValues[value.Option.Name] = value.Value
+		}
+	}
+	if p.IsBundle() {
+		resp.Stock, resp.Available = p.BundleAvailability()
+		resp.Reserved = 0
+		resp.IsBundle = true
+		resp.Components = make([]BundleComponentResponse, len(p.Components))
+		for i, component := range p.Components {
+			resp.Components[i] = component.ToResponse()
+		}
+	}
+	if p.VariantCount > 0 {
+		stock := p.Stock + p.VariantStock
+		available := p.Available() + p.VariantAvailable
+		resp.VariantCount = p.VariantCount
+		resp.AggregateStock = &stock
+		resp.AggregateAvailable = &available
+	}
 	return resp
 }
 
-// CreateProductRequest is the DTO for creating a product
+// CreateProductRequest is the DTO for creating a product. UnitCost is the
+// cost per base unit of the initial stock, in the product's currency, and
+// starts the average cost. Currency is an ISO 4217 code and defaults to DefaultCurrency.
+// TaxClassID assigns the product to a tax class.
 type CreateProductRequest struct {
-	Name        string  `json:"name" binding:"required,min=1,max=200"`
-	Description string  `json:"description" binding:"max=1000"`
-	SKU         string  `json:"sku" binding:"required,min=1,max=50"`
-	Stock       int     `json:"stock" binding:"gte=0"`
-	Price       float64 `json:"price" binding:"required,gt=0"`
-	CategoryID  uint    `json:"category_id"`
-	CategoryIDs []uint  `json:"category_ids"`
+	Name              string   `json:"name" binding:"required,min=1,max=200"`
+	Description       string   `json:"description" binding:"max=1000"`
+	SKU               string   `json:"sku" binding:"required,min=1,max=50"`
+	Stock             Quantity `json:"stock" binding:"gte=0" swaggertype:"number"`
+	Price             Amount   `json:"price" binding:"required,gt=0" swaggertype:"number"`
+	Currency          string   `json:"currency" binding:"omitempty,iso4217"`
+	CategoryID        uint     `json:"category_id"`
+	CategoryIDs       []uint   `json:"category_ids"`
+	ReorderPoint      Quantity `json:"reorder_point" binding:"gte=0" swaggertype:"number"`
+	ReorderQuantity   Quantity `json:"reorder_quantity" binding:"gte=0" swaggertype:"number"`
+	LotTracked        bool     `json:"lot_tracked"`
+	Serialized        bool     `json:"serialized"`
+	BaseUnit          string   `json:"base_unit" binding:"max=20"`
+	QuantityPrecision int      `json:"quantity_precision" binding:"min=0,max=3"`
+	UnitCost          Cost     `json:"unit_cost" binding:"gte=0" swaggertype:"number"`
+	TaxClassID        *uint    `json:"tax_class_id"`
 }
 
-// UpdateProductRequest is the DTO for updating a product
+// UpdateProductRequest is the DTO for updating a product. A TaxClassID of 0
+// removes the product from its tax class.
 type UpdateProductRequest struct {
-	Name        string   `json:"name" binding:"omitempty,min=1,max=200"`
-	Description string   `json:"description" binding:"max=1000"`
-	SKU         string   `json:"sku" binding:"omitempty,min=1,max=50"`
-	Stock       *int     `json:"stock"`
-	Price       *float64 `json:"price"`
-	CategoryID  uint     `json:"category_id" binding:"omitempty"`
-	CategoryIDs []uint   `json:"category_ids"`
+	Name              string    `json:"name" binding:"omitempty,min=1,max=200"`
+	Description       string    `json:"description" binding:"max=1000"`
+	SKU               string    `json:"sku" binding:"omitempty,min=1,max=50"`
+	Stock             *Quantity `json:"stock" binding:"omitempty,gte=0" swaggertype:"number"`
+	Price             *Amount   `json:"price" binding:"omitempty,gt=0" swaggertype:"number"`
+	Currency          string    `json:"currency" binding:"omitempty,iso4217"`
+	CategoryID        uint      `json:"category_id" binding:"omitempty"`
+	CategoryIDs       []uint    `json:"category_ids"`
+	ReorderPoint      *Quantity `json:"reorder_point" binding:"omitempty,gte=0" swaggertype:"number"`
+	ReorderQuantity   *Quantity `json:"reorder_quantity" binding:"omitempty,gte=0" swaggertype:"number"`
+	LotTracked        *bool     `json:"lot_tracked"`
+	Serialized        *bool     `json:"serialized"`
+	BaseUnit          string    `json:"base_unit" binding:"max=20"`
+	QuantityPrecision *int      `json:"quantity_precision" binding:"omitempty,min=0,max=3"`
+	TaxClassID        *uint     `json:"tax_class_id"`
 }
 
-// UpdateStockRequest is the DTO for updating product stock
+// UpdateStockRequest is the DTO for updating product stock. Stock is in
+// Unit, one of the product's units, or the base unit if empty.
 type UpdateStockRequest struct {
-	Stock int `json:"stock" binding:"required"`
+	Stock Quantity `json:"stock" binding:"required,gte=0" swaggertype:"number"`
+	Unit  string   `json:"unit" binding:"max=20"`
 }
 
@@ -6,12 +6,15 @@ import (
 
 // ProductHistory tracks changes to product price and stock
 type ProductHistory struct {
-	ID        uint      `gorm:"primaryKey" json:"id"`
-	ProductID uint      `gorm:"not null;index" json:"product_id"`
-	Product   Product   `gorm:"foreignKey:ProductID" json:"-"`
-	Price     float64   `gorm:"not null;type:decimal(10,2)" json:"price"`
-	Stock     int       `gorm:"not null" json:"stock"`
-	ChangedAt time.Time `gorm:"not null;index" json:"changed_at"`
+	ID         uint           `gorm:"primaryKey" json:"id"`
+	ProductID  uint           `gorm:"not null;index;index:idx_product_history_product_changed" json:"product_id"`
+	Product    Product        `gorm:"foreignKey:ProductID" json:"-"`
+	Price      Amount         `gorm:"not null;type:decimal(10,2)" json:"price" swaggertype:"number"`
+	Currency   string         `gorm:"not null;size:3;default:'USD'" json:"currency"`
+	Stock      Quantity       `gorm:"not null;type:decimal(14,3)" json:"stock" swaggertype:"number"`
+	MovementID *uint          `gorm:"index" json:"movement_id,omitempty"`
+	Movement   *StockMovement `gorm:"foreignKey:MovementID" json:"-"`
+	ChangedAt  time.Time      `gorm:"not null;index;index:idx_product_history_product_changed" json:"changed_at"`
 }
 
 // TableName specifies the table name for ProductHistory model
@@ -21,22 +24,32 @@ func (ProductHistory) TableName() string {
 
 // ProductHistoryResponse is the DTO for product history responses
 type ProductHistoryResponse struct {
-	ID        uint      `json:"id"`
-	ProductID uint      `json:"product_id"`
-	Price     float64   `json:"price"`
-	Stock     int       `json:"stock"`
-	ChangedAt time.Time `json:"changed_at"`
+	ID         uint                   `json:"id"`
+	ProductID  uint                   `json:"product_id"`
+	Price      Amount                 `json:"price" swaggertype:"number"`
+	Currency   string                 `json:"currency"`
+	Stock      Quantity               `json:"stock" swaggertype:"number"`
+	MovementID *uint                  `json:"movement_id,omitempty"`
+	Movement   *StockMovementResponse `json:"movement,omitempty"`
+	ChangedAt  time.Time              `json:"changed_at"`
 }
 
 // ToResponse converts ProductHistory to ProductHistoryResponse
 func (h *ProductHistory) ToResponse() ProductHistoryResponse {
-	return ProductHistoryResponse{
-		ID:        h.ID,
-		ProductID: h.ProductID,
-		Price:     h.Price,
-		Stock:     h.Stock,
-		ChangedAt: h.ChangedAt,
+	response := ProductHistoryResponse{
+		ID:         h.ID,
+		ProductID:  h.ProductID,
+		Price:      h.Price,
+		Currency:   h.Currency,
+		Stock:      h.Stock,
+		MovementID: h.MovementID,
+		ChangedAt:  h.ChangedAt,
 	}
+	if h.Movement != nil {
+		movement := h.Movement.ToResponse()
+		response.Movement = &movement
+	}
+	return response
 }
 
 // ProductHistoryQuery is the DTO for history query parameters
@@ -0,0 +1,118 @@
+package models
+
+// DefaultBaseUnit is the base unit of products that do not name one
+const DefaultBaseUnit = "each"
+
+// ProductUnit is an alternative unit a product is bought, sold or counted
+// in, such as a box of 100. Factor is the number of base units it holds.
+type ProductUnit struct {
+	ID        uint     `gorm:"primaryKey" json:"id"`
+	ProductID uint     `gorm:"not null;uniqueIndex:idx_product_units_product_name" json:"product_id"`
+	Name      string   `gorm:"not null;size:20;uniqueIndex:idx_product_units_product_name" json:"name"`
+	Factor    Quantity `gorm:"not null;type:decimal(14,3)" json:"factor" swaggertype:"number"`
+}
+
+// TableName specifies the table name for ProductUnit model
+func (ProductUnit) TableName() string {
+	return "product_units"
+}
+
+// ProductUnitResponse is the DTO for an alternative unit
+type ProductUnitResponse struct {
+	Name   string   `json:"name"`
+	Factor Quantity `json:"factor" swaggertype:"number"`
+}
+
+// UnitFactor returns the number of base units in one of the product's
+// units. An empty unit is the base unit. Units must be loaded.
+func (p *Product) UnitFactor(unit string) (Quantity, bool) {
+	if unit == "" || unit == p.BaseUnit {
+		return Units(1), true
+	}
+	for _, u := range p.Units {
+		if u.Name == unit && u.Factor > 0 {
+			return u.Factor, true
+		}
+	}
+	return 0, false
+}
+
+// ValidUnitFactor reports whether f is a positive number of base units. The
+// factor column holds any Quantity exactly.
+func ValidUnitFactor(f Quantity) bool {
+	return f > 0
+}
+
+// ProductUnitQuantities renders a product's stock figures in one of its units
+type ProductUnitQuantities struct {
+	Unit      string   `json:"unit"`
+	Factor    Quantity `json:"factor" swaggertype:"number"`
+	Stock     Quantity `json:"stock" swaggertype:"number"`
+	Reserved  Quantity `json:"reserved" swaggertype:"number"`
+	Available Quantity `json:"available" swaggertype:"number"`
+}
+
+// ToResponseIn converts Product to ProductResponse with its stock figures
+// also rendered in unit. It reports false if the product has no such unit.
+func (p *Product) ToResponseIn(unit string) (ProductResponse, bool) {
+	resp := p.ToResponse()
+	if unit == "" {
+		return resp, true
+	}
+	factor, ok := p.UnitFactor(unit)
+	if !ok {
+		return resp, false
+	}
+	resp.InUnit = &ProductUnitQuantities{
+		Unit:      unit,
+		Factor:    factor,
+		Stock:     resp.Stock.Div(factor),
+		Reserved:  resp.Reserved.Div(factor),
+		Available: resp.Available.Div(factor),
+	}
+	return resp, true
+}
+
+// MovementUnitQuantities renders a movement's quantities in one of the
+// product's units
+type MovementUnitQuantities struct {
+	Unit       string   `json:"unit"`
+	Factor     Quantity `json:"factor" swaggertype:"number"`
+	Quantity   Quantity `json:"quantity" swaggertype:"number"`
+	StockAfter Quantity `json:"stock_after" swaggertype:"number"`
+}
+
+// ToResponseIn converts StockMovement to StockMovementResponse with its
+// quantities also rendered in a unit holding factor base units
+func (m *StockMovement) ToResponseIn(unit string, factor Quantity) StockMovementResponse {
+	resp := m.ToResponse()
+	if unit == "" || factor <= 0 {
+		return resp
+	}
+	resp.InUnit = &MovementUnitQuantities{
+		Unit:       unit,
+		Factor:     factor,
+		Quantity:   m.Quantity.Div(factor),
+		StockAfter: m.StockAfter.Div(factor),
+	}
+	return resp
+}
+
+// ProductUnitRequest is the DTO for an alternative unit. Factor is stored
+// to MaxQuantityPrecision decimal places, so the smallest is 0.001.
+type ProductUnitRequest struct {
+	Name   string   `json:"name" binding:"required,min=1,max=20"`
+	Factor Quantity `json:"factor" binding:"required,gt=0" swaggertype:"number"`
+}
+
+// SetProductUnitsRequest is the DTO for replacing a product's alternative
+// units, and optionally renaming its base unit
+type SetProductUnitsRequest struct {
+	BaseUnit string               `json:"base_unit" binding:"omitempty,min=1,max=20"`
+	Units    []ProductUnitRequest `json:"units" binding:"max=20,dive"`
+}
+
+// UnitQuery is the DTO for the unit quantities are rendered in
+type UnitQuery struct {
+	Unit string `form:"unit" binding:"max=20"`
+}
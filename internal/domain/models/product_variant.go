@@ -0,0 +1,72 @@
+package models
+
+// ProductOption is an axis, such as size or color, along which the variants
+// of a parent product differ
+type ProductOption struct {
+	ID        uint                 `gorm:"primaryKey" json:"id"`
+	ProductID uint                 `gorm:"not null;uniqueIndex:idx_product_options_product_name" json:"product_id"`
+	Name      string               `gorm:"not null;size:50;uniqueIndex:idx_product_options_product_name" json:"name"`
+	Position  int                  `gorm:"not null;default:0" json:"position"`
+	Values    []ProductOptionValue `gorm:"foreignKey:OptionID" json:"values,omitempty"`
+}
+
+// TableName specifies the table name for ProductOption model
+func (ProductOption) TableName() string {
+	return "product_options"
+}
+
+// ProductOptionValue is one value of an option axis, such as "M" for size
+type ProductOptionValue struct {
+	ID       uint          `gorm:"primaryKey" json:"id"`
+	OptionID uint          `gorm:"not null;uniqueIndex:idx_product_option_values_option_value" json:"option_id"`
+	Option   ProductOption `gorm:"foreignKey:OptionID" json:"-"`
+	Value    string        `gorm:"not null;size:50;uniqueIndex:idx_product_option_values_option_value" json:"value"`
+	Position int           `gorm:"not null;default:0" json:"position"`
+}
+
+// TableName specifies the table name for ProductOptionValue model
+func (ProductOptionValue) TableName() string {
+	return "product_option_values"
+}
+
+// ProductOptionResponse is the DTO for an option axis and its values
+type ProductOptionResponse struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// ToResponse converts ProductOption to ProductOptionResponse
+func (o *ProductOption) ToResponse() ProductOptionResponse {
+	resp := ProductOptionResponse{
+		Name:   o.Name,
+		Values: make([]string, len(o.Values)),
+	}
+	for i, value := range o.Values {
+		resp.Values[i] = value.Value
+	}
+	return resp
+}
+
+// VariantOptionRequest is the DTO for an option axis and its values
+type VariantOptionRequest struct {
+	Name   string   `json:"name" binding:"required,min=1,max=50"`
+	Values []string `json:"values" binding:"required,min=1,max=50,dive,min=1,max=50"`
+}
+
+// GenerateVariantsRequest is the DTO for generating a parent product's
+// variant matrix. The first call defines the option axes; later calls must
+// name the same axes and may add values to them. Price defaults to the
+// parent's price.
+type GenerateVariantsRequest struct {
+	Options []VariantOptionRequest `json:"options" binding:"required,min=1,max=3,dive"`
+	Price   *Amount                `json:"price" binding:"omitempty,gt=0" swaggertype:"number"`
+}
+
+// VariantMatrixResponse is the DTO for a parent product's option axes and
+// variants. Created counts the variants added by a generate request.
+type VariantMatrixResponse struct {
+	ParentID uint                    `json:"parent_id"`
+	Options  []ProductOptionResponse `json:"options"`
+	Variants []ProductResponse       `json:"variants"`
+	Created  int                     `json:"created"`
+}
@@ -0,0 +1,205 @@
+package models
+
+import (
+	"time"
+)
+
+// PurchaseOrderStatus is the lifecycle state of a purchase order
+type PurchaseOrderStatus string
+
+const (
+	PurchaseOrderDraft             PurchaseOrderStatus = "draft"
+	PurchaseOrderSent              PurchaseOrderStatus = "sent"
+	PurchaseOrderPartiallyReceived PurchaseOrderStatus = "partially_received"
+	PurchaseOrderReceived          PurchaseOrderStatus = "received"
+	PurchaseOrderClosed            PurchaseOrderStatus = "closed"
+)
+
+// PurchaseOrder is an order placed with a supplier for delivery to a warehouse
+type PurchaseOrder struct {
+	ID          uint                `gorm:"primaryKey" json:"id"`
+	Number      string              `gorm:"size:20;index" json:"number"`
+	SupplierID  uint                `gorm:"not null;index" json:"supplier_id"`
+	Supplier    Supplier            `gorm:"foreignKey:SupplierID" json:"-"`
+	WarehouseID uint                `gorm:"not null;index" json:"warehouse_id"`
+	Warehouse   Warehouse           `gorm:"foreignKey:WarehouseID" json:"-"`
+	Status      PurchaseOrderStatus `gorm:"type:varchar(20);not null;default:'draft';index" json:"status"`
+	Notes       string              `gorm:"size:1000" json:"notes"`
+	Currency    string              `gorm:"not null;size:3;default:'USD'" json:"currency"`
+	ExpectedAt  *time.Time          `json:"expected_at,omitempty"`
+	SentAt      *time.Time          `json:"sent_at,omitempty"`
+	ReceivedAt  *time.Time          `json:"received_at,omitempty"`
+	ClosedAt    *time.Time          `json:"closed_at,omitempty"`
+	UserID      *uint               `gorm:"index" json:"user_id,omitempty"`
+	Lines       []PurchaseOrderLine `gorm:"foreignKey:PurchaseOrderID" json:"lines"`
+	CreatedAt   time.Time           `json:"created_at"`
+	UpdatedAt   time.Time           `json:"updated_at"`
+}
+
+// TableName specifies the table name for PurchaseOrder model
+func (PurchaseOrder) TableName() string {
+	return "purchase_orders"
+}
+
+// PurchaseOrderLine is a product and quantity ordered on a purchase order.
+// Quantities are in the product's base unit; UnitCost is the cost of one
+// Unit as ordered, which holds UnitFactor base units (an empty Unit is the
+// base unit).
+type PurchaseOrderLine struct {
+	ID               uint     `gorm:"primaryKey" json:"id"`
+	PurchaseOrderID  uint     `gorm:"not null;index" json:"purchase_order_id"`
+	ProductID        uint     `gorm:"not null;index" json:"product_id"`
+	Product          Product  `gorm:"foreignKey:ProductID" json:"-"`
+	QuantityOrdered  Quantity `gorm:"not null;type:decimal(14,3)" json:"quantity_ordered" swaggertype:"number"`
+	QuantityReceived Quantity `gorm:"not null;type:decimal(14,3);default:0" json:"quantity_received" swaggertype:"number"`
+	UnitCost         Amount   `gorm:"not null;type:decimal(10,2)" json:"unit_cost" swaggertype:"number"`
+	Unit             string   `gorm:"size:20" json:"unit"`
+	UnitFactor       Quantity `gorm:"not null;type:decimal(14,3);default:1" json:"unit_factor" swaggertype:"number"`
+}
+
+// TableName specifies the table name for PurchaseOrderLine model
+func (PurchaseOrderLine) TableName() string {
+	return "purchase_order_lines"
+}
+
+// Cost returns the cost of a quantity of the line's product in base units
+func (l *PurchaseOrderLine) Cost(quantity Quantity) Amount {
+	return l.UnitCost.Scaled(quantity, l.UnitFactor)
+}
+
+// Outstanding returns the quantity still to be received
+func (l *PurchaseOrderLine) Outstanding() Quantity {
+	if l.QuantityReceived >= l.QuantityOrdered {
+		return 0
+	}
+	return l.QuantityOrdered - l.QuantityReceived
+}
+
+// PurchaseOrderResponse is the DTO for purchase order responses
+type PurchaseOrderResponse struct {
+	ID           uint                        `json:"id"`
+	Number       string                      `json:"number"`
+	SupplierID   uint                        `json:"supplier_id"`
+	SupplierName string                      `json:"supplier_name,omitempty"`
+	WarehouseID  uint                        `json:"warehouse_id"`
+	Status       PurchaseOrderStatus         `json:"status"`
+	Notes        string                      `json:"notes"`
+	Currency     string                      `json:"currency"`
+	Total        Amount                      `json:"total" swaggertype:"number"`
+	ExpectedAt   *time.Time                  `json:"expected_at,omitempty"`
+	SentAt       *time.Time                  `json:"sent_at,omitempty"`
+	ReceivedAt   *time.Time                  `json:"received_at,omitempty"`
+	ClosedAt     *time.Time                  `json:"closed_at,omitempty"`
+	UserID       *uint                       `json:"user_id,omitempty"`
+	Lines        []PurchaseOrderLineResponse `json:"lines"`
+	CreatedAt    time.Time                   `json:"created_at"`
+	UpdatedAt    time.Time                   `json:"updated_at"`
+}
+
+// PurchaseOrderLineResponse is the DTO for purchase order line responses
+type PurchaseOrderLineResponse struct {
+	ID               uint     `json:"id"`
+	ProductID        uint     `json:"product_id"`
+	ProductName      string   `json:"product_name,omitempty"`
+	SKU              string   `json:"sku,omitempty"`
+	QuantityOrdered  Quantity `json:"quantity_ordered" swaggertype:"number"`
+	QuantityReceived Quantity `json:"quantity_received" swaggertype:"number"`
+	UnitCost         Amount   `json:"unit_cost" swaggertype:"number"`
+	Unit             string   `json:"unit"`
+	UnitFactor       Quantity `json:"unit_factor" swaggertype:"number"`
+}
+
+// ToResponse converts PurchaseOrder to PurchaseOrderResponse
+func (o *PurchaseOrder) ToResponse() PurchaseOrderResponse {
+	response := PurchaseOrderResponse{
+		ID:           o.ID,
+		Number:       o.Number,
+		SupplierID:   o.SupplierID,
+		SupplierName: o.Supplier.Name,
+		WarehouseID:  o.WarehouseID,
+		Status:       o.Status,
+		Notes:        o.Notes,
+		Currency:     o.Currency,
+		ExpectedAt:   o.ExpectedAt,
+		SentAt:       o.SentAt,
+		ReceivedAt:   o.ReceivedAt,
+		ClosedAt:     o.ClosedAt,
+		UserID:       o.UserID,
+		Lines:        make([]PurchaseOrderLineResponse, len(o.Lines)),
+		CreatedAt:    o.CreatedAt,
+		UpdatedAt:    o.UpdatedAt,
+	}
+
+	for i, line := range o.Lines {
+		response.Lines[i] = PurchaseOrderLineResponse{
+			ID:               line.ID,
+			ProductID:        line.ProductID,
+			ProductName:      line.Product.Name,
+			SKU:              line.Product.SKU,
+			QuantityOrdered:  line.QuantityOrdered,
+			QuantityReceived: line.QuantityReceived,
+			UnitCost:         line.UnitCost,
+			Unit:             line.Unit,
+			UnitFactor:       line.UnitFactor,
+		}
+		response.Total += line.Cost(line.QuantityOrdered)
+	}
+
+	return response
+}
+
+// PurchaseOrderLineRequest is the DTO for a line on a purchase order.
+// Quantity and UnitCost are per Unit, the base unit if empty.
+type PurchaseOrderLineRequest struct {
+	ProductID uint     `json:"product_id" binding:"required"`
+	Quantity  Quantity `json:"quantity" binding:"required,gt=0" swaggertype:"number"`
+	UnitCost  Amount   `json:"unit_cost" binding:"gte=0" swaggertype:"number"`
+	Unit      string   `json:"unit" binding:"max=20"`
+}
+
+// CreatePurchaseOrderRequest is the DTO for creating a draft purchase order.
+// WarehouseID is where goods are received (default warehouse if empty).
+// Currency is the ISO 4217 code of the unit costs and defaults to
+// DefaultCurrency.
+type CreatePurchaseOrderRequest struct {
+	SupplierID  uint                       `json:"supplier_id" binding:"required"`
+	WarehouseID uint                       `json:"warehouse_id"`
+	Notes       string                     `json:"notes" binding:"max=1000"`
+	Currency    string                     `json:"currency" binding:"omitempty,iso4217"`
+	ExpectedAt  *time.Time                 `json:"expected_at"`
+	Lines       []PurchaseOrderLineRequest `json:"lines" binding:"required,min=1,dive"`
+}
+
+// UpdatePurchaseOrderRequest is the DTO for updating a draft purchase order.
+// Lines, when given, replace all existing lines.
+type UpdatePurchaseOrderRequest struct {
+	SupplierID  uint                       `json:"supplier_id"`
+	WarehouseID uint                       `json:"warehouse_id"`
+	Notes       string                     `json:"notes" binding:"max=1000"`
+	Currency    string                     `json:"currency" binding:"omitempty,iso4217"`
+	ExpectedAt  *time.Time                 `json:"expected_at"`
+	Lines       []PurchaseOrderLineRequest `json:"lines" binding:"omitempty,min=1,dive"`
+}
+
+// ReceiveLineRequest is the DTO for the quantity received against one line.
+// Lot-tracked products must name the lot the goods belong to and serialized
+// products must list the units received.
+type ReceiveLineRequest struct {
+	LineID   uint     `json:"line_id" binding:"required"`
+	Quantity Quantity `json:"quantity" binding:"required,gt=0" swaggertype:"number"`
+	Unit     string   `json:"unit" binding:"max=20"`
+	LotInput
+	SerialInput
+}
+
+// ReceivePurchaseOrderRequest is the DTO for receiving goods on a purchase order
+type ReceivePurchaseOrderRequest struct {
+	Lines []ReceiveLineRequest `json:"lines" binding:"required,min=1,dive"`
+}
+
+// PurchaseOrderListQuery is the DTO for purchase order listing query parameters
+type PurchaseOrderListQuery struct {
+	PaginationRequest
+	SupplierID uint   `form:"supplier_id"`
+	Status     string `form:"status" binding:"omitempty,oneof=draft sent partially_received received closed"`
+}
@@ -0,0 +1,217 @@
+package models
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// MaxQuantityPrecision is the largest number of decimal places a product's
+// quantities may have, matching the scale of the quantity columns
+const MaxQuantityPrecision = 3
+
+var ErrInvalidQuantity = errors.New("quantity must be a decimal number with at most three decimal places")
+
+// Quantity is an exact quantity in thousandths of a unit, the scale of the
+// decimal(14,3) columns quantities are stored in. Like Amount and Cost, it
+// is read and written as a decimal number, never through a float, so stock
+// checks and variances cannot drift.
+type Quantity int64
+
+// Units returns a quantity of n whole units
+func Units(n int64) Quantity {
+	return Quantity(n * 1000)
+}
+
+// ParseQuantity parses a decimal number such as "2.5" into a Quantity. It
+// rejects values with non-zero digits past the third decimal place.
+func ParseQuantity(s string) (Quantity, error) {
+	s = strings.TrimSpace(s)
+	if strings.Contains(s, "/") {
+		return 0, ErrInvalidQuantity
+	}
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return 0, ErrInvalidQuantity
+	}
+	r.Mul(r, big.NewRat(1000, 1))
+	if !r.IsInt() || !r.Num().IsInt64() {
+		return 0, ErrInvalidQuantity
+	}
+	return Quantity(r.Num().Int64()), nil
+}
+
+// QuantityFromFloat rounds a computed figure such as a forecast half away
+// from zero to the thousandth
+func QuantityFromFloat(f float64) Quantity {
+	return Quantity(math.Round(f * 1000))
+}
+
+// QueryQuantity converts a quantity given as a query parameter, 1 if none
+// was given. It rejects values with more than three decimal places.
+func QueryQuantity(f float64) (Quantity, error) {
+	if f <= 0 {
+		return Units(1), nil
+	}
+	return ParseQuantity(strconv.FormatFloat(f, 'f', -1, 64))
+}
+
+// String renders the quantity without trailing zeros, such as "2.5" or "12"
+func (q Quantity) String() string {
+	sign := ""
+	n := int64(q)
+	if n < 0 {
+		sign = "-"
+		n = -n
+	}
+	if n%1000 == 0 {
+		return fmt.Sprintf("%s%d", sign, n/1000)
+	}
+	return sign + strings.TrimRight(fmt.Sprintf("%d.%03d", n/1000, n%1000), "0")
+}
+
+// Float64 returns the quantity as a float, for statistics such as averages
+// and rates that are not quantities themselves
+func (q Quantity) Float64() float64 {
+	return float64(q) / 1000
+}
+
+// Abs returns the quantity without its sign
+func (q Quantity) Abs() Quantity {
+	if q < 0 {
+		return -q
+	}
+	return q
+}
+
+// Mul returns q times factor, such as the base units in q boxes of factor
+// units each, rounded half away from zero to the thousandth
+func (q Quantity) Mul(factor Quantity) Quantity {
+	return scaleQuantity(int64(q), int64(factor), 1000)
+}
+
+// Div returns q divided by factor, such as the boxes of factor units each
+// that q base units make up, rounded half away from zero to the thousandth.
+// A factor of zero or less leaves the quantity unchanged.
+func (q Quantity) Div(factor Quantity) Quantity {
+	if factor <= 0 {
+		return q
+	}
+	return scaleQuantity(int64(q), 1000, int64(factor))
+}
+
+// Whole returns the number of complete lots of per units that q makes up,
+// rounded down. A per of zero or less gives zero.
+func (q Quantity) Whole(per Quantity) Quantity {
+	if per <= 0 {
+		return 0
+	}
+	n := int64(q) / int64(per)
+	if int64(q)%int64(per) != 0 && q < 0 {
+		n--
+	}
+	return Units(n)
+}
+
+// scaleQuantity returns n*mul/div in thousandths, rounded half away from
+// zero. The product is taken in big integers so it cannot overflow.
+func scaleQuantity(n, mul, div int64) Quantity {
+	num := new(big.Int).Mul(big.NewInt(n), big.NewInt(mul))
+	quo, rem := new(big.Int).QuoRem(num, big.NewInt(div), new(big.Int))
+	if twice := new(big.Int).Abs(rem); twice.Lsh(twice, 1).Cmp(big.NewInt(div)) >= 0 {
+		if num.Sign() < 0 {
+			quo.Sub(quo, big.NewInt(1))
+		} else {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+	return Quantity(quo.Int64())
+}
+
+// Value stores the quantity as a decimal string so the column keeps it exactly
+func (q Quantity) Value() (driver.Value, error) {
+	return q.String(), nil
+}
+
+// Scan reads a decimal column into the quantity
+func (q *Quantity) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*q = 0
+		return nil
+	case string:
+		return q.parse(v)
+	case []byte:
+		return q.parse(string(v))
+	case int64:
+		*q = Units(v)
+		return nil
+	case float64:
+		return q.parse(strconv.FormatFloat(v, 'f', 3, 64))
+	default:
+		return fmt.Errorf("cannot scan %T into Quantity", src)
+	}
+}
+
+// MarshalJSON writes the quantity as a JSON number
+func (q Quantity) MarshalJSON() ([]byte, error) {
+	return []byte(q.String()), nil
+}
+
+// UnmarshalJSON reads the quantity from a JSON number or a quoted decimal
+func (q *Quantity) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	return q.parse(s)
+}
+
+func (q *Quantity) parse(s string) error {
+	parsed, err := ParseQuantity(s)
+	if err != nil {
+		return err
+	}
+	*q = parsed
+	return nil
+}
+
+// ValidQuantity reports whether q has no more decimal places than the
+// product's quantity precision allows
+func (p *Product) ValidQuantity(q Quantity) bool {
+	step := quantityStep(p.QuantityPrecision)
+	return int64(q)%step == 0
+}
+
+// CeilQuantity rounds a computed figure up to the product's quantity
+// precision, for quantities such as order suggestions that must not fall
+// short
+func (p *Product) CeilQuantity(f float64) Quantity {
+	step := quantityStep(p.QuantityPrecision)
+	// Round to the thousandth first so float error cannot add a step
+	milli := math.Round(f * 1000)
+	return Quantity(int64(math.Ceil(milli/float64(step))) * step)
+}
+
+// quantityStep returns the thousandths in the smallest quantity a product
+// of the given precision can hold
+func quantityStep(precision int) int64 {
+	if precision < 0 {
+		precision = 0
+	}
+	if precision > MaxQuantityPrecision {
+		precision = MaxQuantityPrecision
+	}
+	step := int64(1)
+	for i := precision; i < MaxQuantityPrecision; i++ {
+		step *= 10
+	}
+	return step
+}
@@ -0,0 +1,143 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseQuantity(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    Quantity
+		wantErr bool
+	}{
+		{"12", 12000, false},
+		{"1.25", 1250, false},
+		{"0.001", 1, false},
+		{" 2.5 ", 2500, false},
+		{"-1.5", -1500, false},
+		{"1.2500", 1250, false},
+		{"1e2", 100000, false},
+		{"0.0005", 0, true},
+		{"1/2", 0, true},
+		{"abc", 0, true},
+		{"", 0, true},
+		{"99999999999999999999", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, err := ParseQuantity(tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseQuantity(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseQuantity(%q) = %d, want %d", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQuantityString(t *testing.T) {
+	tests := []struct {
+		q    Quantity
+		want string
+	}{
+		{12000, "12"},
+		{1250, "1.25"},
+		{1, "0.001"},
+		{-1500, "-1.5"},
+		{-5, "-0.005"},
+		{0, "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := tt.q.String(); got != tt.want {
+				t.Errorf("Quantity(%d).String() = %q, want %q", tt.q, got, tt.want)
+			}
+			data, err := json.Marshal(tt.q)
+			if err != nil || string(data) != tt.want {
+				t.Fatalf("json.Marshal(Quantity(%d)) = %s, %v", tt.q, data, err)
+			}
+			var back Quantity
+			if err := json.Unmarshal(data, &back); err != nil || back != tt.q {
+				t.Errorf("json.Unmarshal(%s) = %d, %v, want %d", data, back, err, tt.q)
+			}
+		})
+	}
+}
+
+func TestQuantityArithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Quantity
+		want Quantity
+	}{
+		{"sum of fractions", 100 + 200, 300},
+		{"mul", Quantity(2500).Mul(12000), 30000},
+		{"mul fractional factor", Quantity(2500).Mul(12500), 31250},
+		{"mul rounds half away from zero", Quantity(1).Mul(500), 1},
+		{"mul negative rounds away from zero", Quantity(-1).Mul(500), -1},
+		{"div", Quantity(30000).Div(12000), 2500},
+		{"div rounds half away from zero", Quantity(10000).Div(3000), 3333},
+		{"div rounds up from the half", Quantity(20000).Div(3000), 6667},
+		{"div by zero leaves the quantity", Quantity(5000).Div(0), 5000},
+		{"whole", Quantity(10000).Whole(3000), 3000},
+		{"whole of a fraction", Quantity(2500).Whole(500), 5000},
+		{"whole rounds down below zero", Quantity(-1000).Whole(3000), -1000},
+		{"whole of nothing per", Quantity(10000).Whole(0), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.want {
+				t.Errorf("got %d, want %d", tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidQuantity(t *testing.T) {
+	tests := []struct {
+		name      string
+		precision int
+		q         Quantity
+		want      bool
+	}{
+		{"whole units", 0, 3000, true},
+		{"fraction of a whole unit", 0, 2500, false},
+		{"within precision", 2, 1250, true},
+		{"beyond precision", 2, 1255, false},
+		{"tenths", 1, 100 + 200, true},
+		{"negative", 3, -125, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product := &Product{QuantityPrecision: tt.precision}
+			if got := product.ValidQuantity(tt.q); got != tt.want {
+				t.Errorf("ValidQuantity(%v) at precision %d = %v, want %v", tt.q, tt.precision, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCeilQuantity(t *testing.T) {
+	tests := []struct {
+		name      string
+		precision int
+		f         float64
+		want      Quantity
+	}{
+		{"whole units", 0, 12, 12000},
+		{"up to a whole unit", 0, 2.1, 3000},
+		{"up to the precision", 2, 1.251, 1260},
+		{"float error", 1, 0.1 + 0.2, 300},
+		{"thousandths", 3, 0.0004, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product := &Product{QuantityPrecision: tt.precision}
+			if got := product.CeilQuantity(tt.f); got != tt.want {
+				t.Errorf("CeilQuantity(%v) at precision %d = %d, want %d", tt.f, tt.precision, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"time"
+)
+
+// ReservationStatus is the lifecycle state of a stock reservation
+type ReservationStatus string
+
+const (
+	ReservationActive    ReservationStatus = "active"
+	ReservationConfirmed ReservationStatus = "confirmed"
+	ReservationReleased  ReservationStatus = "released"
+	ReservationExpired   ReservationStatus = "expired"
+)
+
+// Reservation holds stock for a cart or pending order without decrementing
+// it. WarehouseID, if set, is the warehouse whose stock is held; otherwise
+// the hold is against the product's total stock.
+type Reservation struct {
+	ID          uint              `gorm:"primaryKey" json:"id"`
+	ProductID   uint              `gorm:"not null;index" json:"product_id"`
+	Product     Product           `gorm:"foreignKey:ProductID" json:"-"`
+	WarehouseID *uint             `gorm:"index" json:"warehouse_id,omitempty"`
+	Quantity    Quantity          `gorm:"not null;type:decimal(14,3)" json:"quantity" swaggertype:"number"`
+	Status      ReservationStatus `gorm:"type:varchar(20);not null;default:'active';index" json:"status"`
+	Reference   string            `gorm:"size:100;index" json:"reference"`
+	ExpiresAt   *time.Time        `gorm:"index" json:"expires_at,omitempty"`
+	UserID      *uint             `gorm:"index" json:"user_id,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+// TableName specifies the table name for Reservation model
+func (Reservation) TableName() string {
+	return "reservations"
+}
+
+// ReservationResponse is the DTO for reservation responses
+type ReservationResponse struct {
+	ID          uint              `json:"id"`
+	ProductID   uint              `json:"product_id"`
+	WarehouseID *uint             `json:"warehouse_id,omitempty"`
+	Quantity    Quantity          `json:"quantity" swaggertype:"number"`
+	Status      ReservationStatus `json:"status"`
+	Reference   string            `json:"reference"`
+	ExpiresAt   *time.Time        `json:"expires_at,omitempty"`
+	UserID      *uint             `json:"user_id,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+// ToResponse converts Reservation to ReservationResponse
+func (r *Reservation) ToResponse() ReservationResponse {
+	return ReservationResponse{
+		ID:          r.ID,
+		ProductID:   r.ProductID,
+		WarehouseID: r.WarehouseID,
+		Quantity:    r.Quantity,
+		Status:      r.Status,
+		Reference:   r.Reference,
+		ExpiresAt:   r.ExpiresAt,
+		UserID:      r.UserID,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
+
+// CreateReservationRequest is the DTO for creating a reservation.
+// TTLMinutes defaults to the configured reservation TTL.
+type CreateReservationRequest struct {
+	ProductID  uint     `json:"product_id" binding:"required"`
+	Quantity   Quantity `json:"quantity" binding:"required,gt=0" swaggertype:"number"`
+	TTLMinutes int      `json:"ttl_minutes" binding:"omitempty,min=1,max=43200"`
+	Reference  string   `json:"reference" binding:"max=100"`
+}
+
+// ReservationListQuery is the DTO for reservation listing query parameters
+type ReservationListQuery struct {
+	PaginationRequest
+	ProductID uint   `form:"product_id"`
+	Status    string `form:"status" binding:"omitempty,oneof=active confirmed released expired"`
+}
+
+// ConfirmReservationRequest is the DTO for confirming a reservation.
+// WarehouseID selects where stock is taken from (default warehouse if empty).
+// Serialized products must list the units sold.
+type ConfirmReservationRequest struct {
+	WarehouseID uint `json:"warehouse_id"`
+	SerialInput
+}
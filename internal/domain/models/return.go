@@ -0,0 +1,225 @@
+package models
+
+import (
+	"time"
+)
+
+// ReturnStatus is the processing state of a customer return
+type ReturnStatus string
+
+const (
+	ReturnPending   ReturnStatus = "pending"
+	ReturnInspected ReturnStatus = "inspected"
+	ReturnDisposed  ReturnStatus = "disposed"
+)
+
+// ReturnCondition is the state a returned item was found in on inspection
+type ReturnCondition string
+
+const (
+	ReturnConditionResaleable ReturnCondition = "resaleable"
+	ReturnConditionDamaged    ReturnCondition = "damaged"
+	ReturnConditionDefective  ReturnCondition = "defective"
+)
+
+// ReturnDisposition is what was done with a returned item. Only restock
+// brings it back into stock; refurbish sends it for repair and scrap
+// writes it off.
+type ReturnDisposition string
+
+const (
+	ReturnRestock   ReturnDisposition = "restock"
+	ReturnRefurbish ReturnDisposition = "refurbish"
+	ReturnScrap     ReturnDisposition = "scrap"
+)
+
+// ReturnAuthorization is a customer return (RMA) of a quantity of a product,
+// optionally a single serialized unit or stock from a lot. It is inspected
+// and then disposed of; restocking records a return movement.
+type ReturnAuthorization struct {
+	ID          uint              `gorm:"primaryKey" json:"id"`
+	Number      string            `gorm:"size:20;index" json:"number"`
+	ProductID   uint              `gorm:"not null;index" json:"product_id"`
+	Product     Product           `gorm:"foreignKey:ProductID" json:"-"`
+	Quantity    Quantity          `gorm:"not null;type:decimal(14,3)" json:"quantity" swaggertype:"number"`
+	Serial      string            `gorm:"size:100;index" json:"serial"`
+	LotNumber   string            `gorm:"size:50" json:"lot_number"`
+	Customer    string            `gorm:"size:200" json:"customer"`
+	Reference   string            `gorm:"size:100;index" json:"reference"`
+	Reason      string            `gorm:"size:500" json:"reason"`
+	Status      ReturnStatus      `gorm:"type:varchar(20);not null;default:'pending';index" json:"status"`
+	Condition   ReturnCondition   `gorm:"type:varchar(20)" json:"condition,omitempty"`
+	Disposition ReturnDisposition `gorm:"type:varchar(20);index" json:"disposition,omitempty"`
+	WarehouseID *uint             `gorm:"index" json:"warehouse_id,omitempty"` // Set when restocked
+	MovementID  *uint             `gorm:"index" json:"movement_id,omitempty"`
+	InspectedAt *time.Time        `json:"inspected_at,omitempty"`
+	DisposedAt  *time.Time        `gorm:"index" json:"disposed_at,omitempty"`
+	UserID      *uint             `gorm:"index" json:"user_id,omitempty"`
+	Events      []ReturnEvent     `gorm:"foreignKey:ReturnID" json:"events,omitempty"`
+	CreatedAt   time.Time         `gorm:"index" json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+// TableName specifies the table name for ReturnAuthorization model
+func (ReturnAuthorization) TableName() string {
+	return "returns"
+}
+
+// ReturnEvent records a step in a return's processing: its receipt,
+// inspection and disposition
+type ReturnEvent struct {
+	ID          uint              `gorm:"primaryKey" json:"id"`
+	ReturnID    uint              `gorm:"not null;index" json:"return_id"`
+	Status      ReturnStatus      `gorm:"type:varchar(20);not null" json:"status"`
+	Condition   ReturnCondition   `gorm:"type:varchar(20)" json:"condition,omitempty"`
+	Disposition ReturnDisposition `gorm:"type:varchar(20)" json:"disposition,omitempty"`
+	Notes       string            `gorm:"size:1000" json:"notes"`
+	UserID      *uint             `gorm:"index" json:"user_id,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+}
+
+// TableName specifies the table name for ReturnEvent model
+func (ReturnEvent) TableName() string {
+	return "return_events"
+}
+
+// ReturnResponse is the DTO for return responses. Events are only included
+// for a single return.
+type ReturnResponse struct {
+	ID          uint                  `json:"id"`
+	Number      string                `json:"number"`
+	ProductID   uint                  `json:"product_id"`
+	ProductName string                `json:"product_name,omitempty"`
+	SKU         string                `json:"sku,omitempty"`
+	Quantity    Quantity              `json:"quantity" swaggertype:"number"`
+	Serial      string                `json:"serial,omitempty"`
+	LotNumber   string                `json:"lot_number,omitempty"`
+	Customer    string                `json:"customer"`
+	Reference   string                `json:"reference"`
+	Reason      string                `json:"reason"`
+	Status      ReturnStatus          `json:"status"`
+	Condition   ReturnCondition       `json:"condition,omitempty"`
+	Disposition ReturnDisposition     `json:"disposition,omitempty"`
+	WarehouseID *uint                 `json:"warehouse_id,omitempty"`
+	MovementID  *uint                 `json:"movement_id,omitempty"`
+	InspectedAt *time.Time            `json:"inspected_at,omitempty"`
+	DisposedAt  *time.Time            `json:"disposed_at,omitempty"`
+	UserID      *uint                 `json:"user_id,omitempty"`
+	Events      []ReturnEventResponse `json:"events,omitempty"`
+	CreatedAt   time.Time             `json:"created_at"`
+	UpdatedAt   time.Time             `json:"updated_at"`
+}
+
+// ReturnEventResponse is the DTO for a step in a return's processing
+type ReturnEventResponse struct {
+	Status      ReturnStatus      `json:"status"`
+	Condition   ReturnCondition   `json:"condition,omitempty"`
+	Disposition ReturnDisposition `json:"disposition,omitempty"`
+	Notes       string            `json:"notes"`
+	UserID      *uint             `json:"user_id,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+}
+
+// ToResponse converts ReturnAuthorization to ReturnResponse
+func (r *ReturnAuthorization) ToResponse() ReturnResponse {
+	response := ReturnResponse{
+		ID:          r.ID,
+		Number:      r.Number,
+		ProductID:   r.ProductID,
+		ProductName: r.Product.Name,
+		SKU:         r.Product.SKU,
+		Quantity:    r.Quantity,
+		Serial:      r.Serial,
+		LotNumber:   r.LotNumber,
+		Customer:    r.Customer,
+		Reference:   r.Reference,
+		Reason:      r.Reason,
+		Status:      r.Status,
+		Condition:   r.Condition,
+		Disposition: r.Disposition,
+		WarehouseID: r.WarehouseID,
+		MovementID:  r.MovementID,
+		InspectedAt: r.InspectedAt,
+		DisposedAt:  r.DisposedAt,
+		UserID:      r.UserID,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+
+	for _, event := range r.Events {
+		response.Events = append(response.Events, ReturnEventResponse{
+			Status:      event.Status,
+			Condition:   event.Condition,
+			Disposition: event.Disposition,
+			Notes:       event.Notes,
+			UserID:      event.UserID,
+			CreatedAt:   event.CreatedAt,
+		})
+	}
+
+	return response
+}
+
+// CreateReturnRequest is the DTO for recording a customer return. Quantity is
+// in Unit, or the base unit if empty. Serialized products are returned one
+// unit at a time and must name its serial; LotNumber is the lot restocked
+// stock goes back into.
+type CreateReturnRequest struct {
+	ProductID uint     `json:"product_id" binding:"required"`
+	Quantity  Quantity `json:"quantity" binding:"required,gt=0" swaggertype:"number"`
+	Unit      string   `json:"unit" binding:"max=20"`
+	Serial    string   `json:"serial" binding:"max=100"`
+	LotNumber string   `json:"lot_number" binding:"max=50"`
+	Customer  string   `json:"customer" binding:"max=200"`
+	Reference string   `json:"reference" binding:"max=100"`
+	Reason    string   `json:"reason" binding:"max=500"`
+}
+
+// InspectReturnRequest is the DTO for recording the inspection of a return
+type InspectReturnRequest struct {
+	Condition ReturnCondition `json:"condition" binding:"required,oneof=resaleable damaged defective"`
+	Notes     string          `json:"notes" binding:"max=1000"`
+}
+
+// DisposeReturnRequest is the DTO for disposing of an inspected return.
+// WarehouseID is where restocked stock goes (default warehouse if empty).
+type DisposeReturnRequest struct {
+	Disposition ReturnDisposition `json:"disposition" binding:"required,oneof=restock refurbish scrap"`
+	WarehouseID uint              `json:"warehouse_id"`
+	Notes       string            `json:"notes" binding:"max=1000"`
+}
+
+// ReturnListQuery is the DTO for return listing query parameters
+type ReturnListQuery struct {
+	PaginationRequest
+	ProductID   uint   `form:"product_id"`
+	Status      string `form:"status" binding:"omitempty,oneof=pending inspected disposed"`
+	Disposition string `form:"disposition" binding:"omitempty,oneof=restock refurbish scrap"`
+}
+
+// ReturnSummaryQuery is the DTO for returns summary query parameters
+type ReturnSummaryQuery struct {
+	ProductID uint   `form:"product_id"`
+	Start     string `form:"start"` // Format: YYYY-MM-DD or RFC3339
+	End       string `form:"end"`   // Format: YYYY-MM-DD or RFC3339
+}
+
+// ReturnTotal is the number of returns and units in one group of a summary
+type ReturnTotal struct {
+	Count    int64    `json:"count"`
+	Quantity Quantity `json:"quantity" swaggertype:"number"`
+}
+
+// ReturnSummary totals the returns recorded in a period by status, by
+// condition found on inspection and by disposition. RestockRate is the
+// share of disposed units that went back into stock.
+type ReturnSummary struct {
+	Start         *time.Time             `json:"start,omitempty"`
+	End           *time.Time             `json:"end,omitempty"`
+	ProductID     *uint                  `json:"product_id,omitempty"`
+	Total         ReturnTotal            `json:"total"`
+	ByStatus      map[string]ReturnTotal `json:"by_status"`
+	ByCondition   map[string]ReturnTotal `json:"by_condition"`
+	ByDisposition map[string]ReturnTotal `json:"by_disposition"`
+	RestockRate   float64                `json:"restock_rate"`
+}
@@ -0,0 +1,171 @@
+package models
+
+import (
+	"time"
+)
+
+// SalesOrderStatus is the fulfilment state of a sales order
+type SalesOrderStatus string
+
+const (
+	SalesOrderPending   SalesOrderStatus = "pending"
+	SalesOrderAllocated SalesOrderStatus = "allocated"
+	SalesOrderPicked    SalesOrderStatus = "picked"
+	SalesOrderShipped   SalesOrderStatus = "shipped"
+	SalesOrderCancelled SalesOrderStatus = "cancelled"
+)
+
+// SalesOrder is an outbound customer order fulfilled from a warehouse
+type SalesOrder struct {
+	ID          uint             `gorm:"primaryKey" json:"id"`
+	Number      string           `gorm:"size:20;index" json:"number"`
+	Customer    string           `gorm:"not null;size:200" json:"customer"`
+	WarehouseID uint             `gorm:"not null;index" json:"warehouse_id"`
+	Warehouse   Warehouse        `gorm:"foreignKey:WarehouseID" json:"-"`
+	Status      SalesOrderStatus `gorm:"type:varchar(20);not null;default:'pending';index" json:"status"`
+	Notes       string           `gorm:"size:1000" json:"notes"`
+	Currency    string           `gorm:"not null;size:3;default:'USD'" json:"currency"`
+	AllocatedAt *time.Time       `json:"allocated_at,omitempty"`
+	PickedAt    *time.Time       `json:"picked_at,omitempty"`
+	ShippedAt   *time.Time       `json:"shipped_at,omitempty"`
+	CancelledAt *time.Time       `json:"cancelled_at,omitempty"`
+	UserID      *uint            `gorm:"index" json:"user_id,omitempty"`
+	Lines       []SalesOrderLine `gorm:"foreignKey:SalesOrderID" json:"lines"`
+	CreatedAt   time.Time        `json:"created_at"`
+	UpdatedAt   time.Time        `json:"updated_at"`
+}
+
+// TableName specifies the table name for SalesOrder model
+func (SalesOrder) TableName() string {
+	return "sales_orders"
+}
+
+// SalesOrderLine is a product and quantity on a sales order. Allocating the
+// order holds the quantity with a reservation, which shipping confirms.
+// Quantity is in the product's base unit; UnitPrice is the price of one Unit
+// as ordered, which holds UnitFactor base units (an empty Unit is the base
+// unit).
+type SalesOrderLine struct {
+	ID            uint     `gorm:"primaryKey" json:"id"`
+	SalesOrderID  uint     `gorm:"not null;index" json:"sales_order_id"`
+	ProductID     uint     `gorm:"not null;index" json:"product_id"`
+	Product       Product  `gorm:"foreignKey:ProductID" json:"-"`
+	Quantity      Quantity `gorm:"not null;type:decimal(14,3)" json:"quantity" swaggertype:"number"`
+	UnitPrice     Amount   `gorm:"not null;type:decimal(10,2)" json:"unit_price" swaggertype:"number"`
+	Unit          string   `gorm:"size:20" json:"unit"`
+	UnitFactor    Quantity `gorm:"not null;type:decimal(14,3);default:1" json:"unit_factor" swaggertype:"number"`
+	ReservationID *uint    `gorm:"index" json:"reservation_id,omitempty"`
+}
+
+// TableName specifies the table name for SalesOrderLine model
+func (SalesOrderLine) TableName() string {
+	return "sales_order_lines"
+}
+
+// Total returns the price of the line's quantity
+func (l *SalesOrderLine) Total() Amount {
+	return l.UnitPrice.Scaled(l.Quantity, l.UnitFactor)
+}
+
+// SalesOrderResponse is the DTO for sales order responses
+type SalesOrderResponse struct {
+	ID          uint                     `json:"id"`
+	Number      string                   `json:"number"`
+	Customer    string                   `json:"customer"`
+	WarehouseID uint                     `json:"warehouse_id"`
+	Status      SalesOrderStatus         `json:"status"`
+	Notes       string                   `json:"notes"`
+	Currency    string                   `json:"currency"`
+	Total       Amount                   `json:"total" swaggertype:"number"`
+	AllocatedAt *time.Time               `json:"allocated_at,omitempty"`
+	PickedAt    *time.Time               `json:"picked_at,omitempty"`
+	ShippedAt   *time.Time               `json:"shipped_at,omitempty"`
+	CancelledAt *time.Time               `json:"cancelled_at,omitempty"`
+	UserID      *uint                    `json:"user_id,omitempty"`
+	Lines       []SalesOrderLineResponse `json:"lines"`
+	CreatedAt   time.Time                `json:"created_at"`
+	UpdatedAt   time.Time                `json:"updated_at"`
+}
+
+// SalesOrderLineResponse is the DTO for sales order line responses
+type SalesOrderLineResponse struct {
+	ID            uint     `json:"id"`
+	ProductID     uint     `json:"product_id"`
+	ProductName   string   `json:"product_name,omitempty"`
+	SKU           string   `json:"sku,omitempty"`
+	Quantity      Quantity `json:"quantity" swaggertype:"number"`
+	UnitPrice     Amount   `json:"unit_price" swaggertype:"number"`
+	Unit          string   `json:"unit"`
+	UnitFactor    Quantity `json:"unit_factor" swaggertype:"number"`
+	ReservationID *uint    `json:"reservation_id,omitempty"`
+}
+
+// ToResponse converts SalesOrder to SalesOrderResponse
+func (o *SalesOrder) ToResponse() SalesOrderResponse {
+	response := SalesOrderResponse{
+		ID:          o.ID,
+		Number:      o.Number,
+		Customer:    o.Customer,
+		WarehouseID: o.WarehouseID,
+		Status:      o.Status,
+		Notes:       o.Notes,
+		Currency:    o.Currency,
+		AllocatedAt: o.AllocatedAt,
+		PickedAt:    o.PickedAt,
+		ShippedAt:   o.ShippedAt,
+		CancelledAt: o.CancelledAt,
+		UserID:      o.UserID,
+		Lines:       make([]SalesOrderLineResponse, len(o.Lines)),
+		CreatedAt:   o.CreatedAt,
+		UpdatedAt:   o.UpdatedAt,
+	}
+
+	for i, line := range o.Lines {
+		response.Lines[i] = SalesOrderLineResponse{
+			ID:            line.ID,
+			ProductID:     line.ProductID,
+			ProductName:   line.Product.Name,
+			SKU:           line.Product.SKU,
+			Quantity:      line.Quantity,
+			UnitPrice:     line.UnitPrice,
+			Unit:          line.Unit,
+			UnitFactor:    line.UnitFactor,
+			ReservationID: line.ReservationID,
+		}
+		response.Total += line.Total()
+	}
+
+	return response
+}
+
+// SalesOrderLineRequest is the DTO for a line on a sales order. Quantity
+// and UnitPrice are per Unit, the base unit if empty. UnitPrice defaults to
+// the product's current price for that unit and is in the currency of the
+// product's price.
+type SalesOrderLineRequest struct {
+	ProductID uint     `json:"product_id" binding:"required"`
+	Quantity  Quantity `json:"quantity" binding:"required,gt=0" swaggertype:"number"`
+	UnitPrice *Amount  `json:"unit_price" binding:"omitempty,gte=0" swaggertype:"number"`
+	Unit      string   `json:"unit" binding:"max=20"`
+}
+
+// CreateSalesOrderRequest is the DTO for creating a pending sales order.
+// WarehouseID is where stock ships from (default warehouse if empty).
+type CreateSalesOrderRequest struct {
+	Customer    string                  `json:"customer" binding:"required,min=1,max=200"`
+	WarehouseID uint                    `json:"warehouse_id"`
+	Notes       string                  `json:"notes" binding:"max=1000"`
+	Lines       []SalesOrderLineRequest `json:"lines" binding:"required,min=1,dive"`
+}
+
+// ShipSalesOrderRequest is the DTO for shipping a picked order. Lines of
+// serialized products must list the units shipped.
+type ShipSalesOrderRequest struct {
+	Lines []LineSerialsRequest `json:"lines" binding:"omitempty,dive"`
+}
+
+// SalesOrderListQuery is the DTO for sales order listing query parameters
+type SalesOrderListQuery struct {
+	PaginationRequest
+	Status string `form:"status" binding:"omitempty,oneof=pending allocated picked shipped cancelled"`
+}
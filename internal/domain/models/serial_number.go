@@ -0,0 +1,160 @@
+package models
+
+import (
+	"time"
+)
+
+// SerialStatus is the lifecycle state of a serialized unit
+type SerialStatus string
+
+const (
+	SerialInStock    SerialStatus = "in_stock"
+	SerialInTransit  SerialStatus = "in_transit"
+	SerialSold       SerialStatus = "sold"
+	SerialDamaged    SerialStatus = "damaged"
+	SerialWrittenOff SerialStatus = "written_off"
+	SerialConsumed   SerialStatus = "consumed"
+
+	// SerialRefurbishing marks a returned unit sent for repair; it comes
+	// back into stock through a return movement
+	SerialRefurbishing SerialStatus = "refurbishing"
+)
+
+// SerialNumber is an individual unit of a serialized product. A unit is
+// created by the first movement that brings it into stock and follows the
+// movements that name it from then on.
+type SerialNumber struct {
+	ID          uint         `gorm:"primaryKey" json:"id"`
+	ProductID   uint         `gorm:"not null;index" json:"product_id"`
+	Product     Product      `gorm:"foreignKey:ProductID" json:"-"`
+	Serial      string       `gorm:"not null;size:100;uniqueIndex" json:"serial"`
+	Status      SerialStatus `gorm:"type:varchar(20);not null;index" json:"status"`
+	WarehouseID *uint        `gorm:"index" json:"warehouse_id,omitempty"` // Set while the unit is in stock
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// TableName specifies the table name for SerialNumber model
+func (SerialNumber) TableName() string {
+	return "serial_numbers"
+}
+
+// SerialNumberResponse is the DTO for serial number responses
+type SerialNumberResponse struct {
+	ID          uint         `json:"id"`
+	ProductID   uint         `json:"product_id"`
+	ProductName string       `json:"product_name,omitempty"`
+	SKU         string       `json:"sku,omitempty"`
+	Serial      string       `json:"serial"`
+	Status      SerialStatus `json:"status"`
+	WarehouseID *uint        `json:"warehouse_id,omitempty"`
+	CreatedAt   time.Time    `json:"created_at"`
+	UpdatedAt   time.Time    `json:"updated_at"`
+}
+
+// ToResponse converts SerialNumber to SerialNumberResponse
+func (s *SerialNumber) ToResponse() SerialNumberResponse {
+	return SerialNumberResponse{
+		ID:          s.ID,
+		ProductID:   s.ProductID,
+		ProductName: s.Product.Name,
+		SKU:         s.Product.SKU,
+		Serial:      s.Serial,
+		Status:      s.Status,
+		WarehouseID: s.WarehouseID,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
+
+// SerialEvent records a change of a unit's status that moved no stock,
+// such as a returned unit sent for refurbishment or scrapped
+type SerialEvent struct {
+	ID             uint         `gorm:"primaryKey" json:"id"`
+	SerialNumberID uint         `gorm:"not null;index" json:"serial_number_id"`
+	Status         SerialStatus `gorm:"type:varchar(20);not null" json:"status"`
+	Reason         string       `gorm:"size:255" json:"reason"`
+	Reference      string       `gorm:"size:100" json:"reference"`
+	UserID         *uint        `gorm:"index" json:"user_id,omitempty"`
+	CreatedAt      time.Time    `json:"created_at"`
+}
+
+// TableName specifies the table name for SerialEvent model
+func (SerialEvent) TableName() string {
+	return "serial_events"
+}
+
+// SerialEventResponse is the DTO for a status change of a unit
+type SerialEventResponse struct {
+	Status    SerialStatus `json:"status"`
+	Reason    string       `json:"reason"`
+	Reference string       `json:"reference"`
+	UserID    *uint        `json:"user_id,omitempty"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+// ToResponse converts SerialEvent to SerialEventResponse
+func (e *SerialEvent) ToResponse() SerialEventResponse {
+	return SerialEventResponse{
+		Status:    e.Status,
+		Reason:    e.Reason,
+		Reference: e.Reference,
+		UserID:    e.UserID,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+// SerialTraceResponse is the DTO for a unit together with every movement
+// that named it and every status change outside a movement, oldest first
+type SerialTraceResponse struct {
+	SerialNumberResponse
+	Movements []StockMovementResponse `json:"movements"`
+	Events    []SerialEventResponse   `json:"events"`
+}
+
+// StockMovementSerial links a movement to a unit it moved
+type StockMovementSerial struct {
+	ID             uint         `gorm:"primaryKey" json:"id"`
+	MovementID     uint         `gorm:"not null;index" json:"movement_id"`
+	SerialNumberID uint         `gorm:"not null;index" json:"serial_number_id"`
+	SerialNumber   SerialNumber `gorm:"foreignKey:SerialNumberID" json:"-"`
+}
+
+// TableName specifies the table name for StockMovementSerial model
+func (StockMovementSerial) TableName() string {
+	return "stock_movement_serials"
+}
+
+// SerialInput lists the units a stock change on a serialized product moves.
+// There must be exactly one serial per unit of quantity.
+type SerialInput struct {
+	Serials []string `json:"serials" binding:"omitempty,dive,min=1,max=100"`
+}
+
+// Entries returns the movement entries for the listed serials
+func (in SerialInput) Entries() []StockMovementSerial {
+	if len(in.Serials) == 0 {
+		return nil
+	}
+	entries := make([]StockMovementSerial, len(in.Serials))
+	for i, serial := range in.Serials {
+		entries[i] = StockMovementSerial{SerialNumber: SerialNumber{Serial: serial}}
+	}
+	return entries
+}
+
+// LineSerialsRequest names the units moved for one line of an order or
+// transfer
+type LineSerialsRequest struct {
+	LineID uint `json:"line_id" binding:"required"`
+	SerialInput
+}
+
+// SerialsByLine indexes line serial requests by line ID
+func SerialsByLine(lines []LineSerialsRequest) map[uint]SerialInput {
+	byLine := make(map[uint]SerialInput, len(lines))
+	for _, line := range lines {
+		byLine[line.LineID] = line.SerialInput
+	}
+	return byLine
+}
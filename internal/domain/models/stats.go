@@ -0,0 +1,63 @@
+package models
+
+import (
+	"sort"
+	"time"
+)
+
+// CategoryStats is the DTO for the inventory totals of one primary
+// category. Bundles count as products but hold no stock of their own, so
+// they are left out of the stock figures. Variant parents are left out
+// altogether; their variants count instead.
+type CategoryStats struct {
+	CategoryID   uint            `json:"category_id"`
+	CategoryName string          `json:"category_name"`
+	Products     int64           `json:"products"`
+	Units        Quantity        `json:"units" swaggertype:"number"`
+	StockValues  []CurrencyValue `gorm:"-" json:"stock_values"`
+	OutOfStock   int64           `json:"out_of_stock"`
+	LowStock     int64           `json:"low_stock"`
+}
+
+// CategoryValue is an amount in one currency for one primary category
+type CategoryValue struct {
+	CategoryID uint
+	Currency   string
+	Value      Amount
+}
+
+// CurrencyValue is the DTO for an amount in one currency
+type CurrencyValue struct {
+	Currency string `json:"currency"`
+	Value    Amount `json:"value" swaggertype:"number"`
+}
+
+// AddCurrencyValue adds value to the entry for currency in values, which
+// are kept sorted by currency, and returns the updated values
+func AddCurrencyValue(values []CurrencyValue, currency string, value Amount) []CurrencyValue {
+	i := sort.Search(len(values), func(i int) bool { return values[i].Currency >= currency })
+	if i < len(values) && values[i].Currency == currency {
+		values[i].Value += value
+		return values
+	}
+	values = append(values, CurrencyValue{})
+	copy(values[i+1:], values[i:])
+	values[i] = CurrencyValue{Currency: currency, Value: value}
+	return values
+}
+
+// InventoryStats is the DTO for the dashboard aggregates. Products counts
+// SKUs and Units the stock held. StockValues is the stock at average cost
+// and RetailValues the stock at list price, both per product currency. A product is
+// out of stock with no stock and low on stock at or below its reorder
+// point, as for stock alerts.
+type InventoryStats struct {
+	Products     int64           `json:"products"`
+	Units        Quantity        `json:"units" swaggertype:"number"`
+	StockValues  []CurrencyValue `json:"stock_values"`
+	RetailValues []CurrencyValue `json:"retail_values"`
+	OutOfStock   int64           `json:"out_of_stock"`
+	LowStock     int64           `json:"low_stock"`
+	Categories   []CategoryStats `json:"categories"`
+	GeneratedAt  time.Time       `json:"generated_at"`
+}
@@ -0,0 +1,90 @@
+package models
+
+import (
+	"time"
+)
+
+// AlertType is the severity of a stock alert
+type AlertType string
+
+const (
+	AlertLowStock   AlertType = "low"
+	AlertOutOfStock AlertType = "out"
+)
+
+// AlertStatus is the lifecycle state of a stock alert
+type AlertStatus string
+
+const (
+	AlertOpen         AlertStatus = "open"
+	AlertAcknowledged AlertStatus = "acknowledged"
+	AlertResolved     AlertStatus = "resolved"
+)
+
+// StockAlert is raised when a product's stock falls to its reorder point or runs out
+type StockAlert struct {
+	ID              uint        `gorm:"primaryKey" json:"id"`
+	ProductID       uint        `gorm:"not null;index" json:"product_id"`
+	Product         Product     `gorm:"foreignKey:ProductID" json:"-"`
+	Type            AlertType   `gorm:"type:varchar(10);not null" json:"type"`
+	Status          AlertStatus `gorm:"type:varchar(20);not null;default:'open';index" json:"status"`
+	Stock           Quantity    `gorm:"not null;type:decimal(14,3)" json:"stock" swaggertype:"number"`
+	ReorderPoint    Quantity    `gorm:"not null;type:decimal(14,3)" json:"reorder_point" swaggertype:"number"`
+	ReorderQuantity Quantity    `gorm:"not null;type:decimal(14,3)" json:"reorder_quantity" swaggertype:"number"`
+	AcknowledgedBy  *uint       `json:"acknowledged_by,omitempty"`
+	AcknowledgedAt  *time.Time  `json:"acknowledged_at,omitempty"`
+	ResolvedAt      *time.Time  `json:"resolved_at,omitempty"`
+	CreatedAt       time.Time   `gorm:"index" json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+}
+
+// TableName specifies the table name for StockAlert model
+func (StockAlert) TableName() string {
+	return "stock_alerts"
+}
+
+// StockAlertResponse is the DTO for stock alert responses
+type StockAlertResponse struct {
+	ID              uint        `json:"id"`
+	ProductID       uint        `json:"product_id"`
+	ProductName     string      `json:"product_name,omitempty"`
+	SKU             string      `json:"sku,omitempty"`
+	Type            AlertType   `json:"type"`
+	Status          AlertStatus `json:"status"`
+	Stock           Quantity    `json:"stock" swaggertype:"number"`
+	ReorderPoint    Quantity    `json:"reorder_point" swaggertype:"number"`
+	ReorderQuantity Quantity    `json:"reorder_quantity" swaggertype:"number"`
+	AcknowledgedBy  *uint       `json:"acknowledged_by,omitempty"`
+	AcknowledgedAt  *time.Time  `json:"acknowledged_at,omitempty"`
+	ResolvedAt      *time.Time  `json:"resolved_at,omitempty"`
+	CreatedAt       time.Time   `json:"created_at"`
+	UpdatedAt       time.Time   `json:"updated_at"`
+}
+
+// ToResponse converts StockAlert to StockAlertResponse
+func (a *StockAlert) ToResponse() StockAlertResponse {
+	return StockAlertResponse{
+		ID:              a.ID,
+		ProductID:       a.ProductID,
+		ProductName:     a.Product.Name,
+		SKU:             a.Product.SKU,
+		Type:            a.Type,
+		Status:          a.Status,
+		Stock:           a.Stock,
+		ReorderPoint:    a.ReorderPoint,
+		ReorderQuantity: a.ReorderQuantity,
+		AcknowledgedBy:  a.AcknowledgedBy,
+		AcknowledgedAt:  a.AcknowledgedAt,
+		ResolvedAt:      a.ResolvedAt,
+		CreatedAt:       a.CreatedAt,
+		UpdatedAt:       a.UpdatedAt,
+	}
+}
+
+// StockAlertQuery is the DTO for alert listing query parameters
+type StockAlertQuery struct {
+	PaginationRequest
+	ProductID uint   `form:"product_id"`
+	Type      string `form:"type" binding:"omitempty,oneof=low out"`
+	Status    string `form:"status" binding:"omitempty,oneof=open acknowledged resolved"`
+}
@@ -0,0 +1,151 @@
+package models
+
+import (
+	"time"
+)
+
+// Bases for ranking products in an ABC analysis
+const (
+	ABCByValue    = "value"
+	ABCByTurnover = "turnover"
+)
+
+// ProductUsage is a product's stock over a window as read from its history:
+// the time-weighted average stock held and the stock used up
+type ProductUsage struct {
+	ProductID    uint
+	SKU          string
+	Name         string
+	CategoryID   uint
+	CategoryName string
+	AverageCost  Cost
+	AverageStock float64
+	Usage        Quantity
+}
+
+// ABCLine is the DTO for one product's place in an ABC analysis.
+// StockValue is the average stock held over the window at the product's
+// average cost and UsageValue the cost of the stock used up; Turnover is
+// usage over average stock and is omitted when no stock was held. Share is
+// the product's share of the total of the ranking basis and CumulativeShare
+// the running total down to and including it, both in percent.
+type ABCLine struct {
+	Rank            int      `json:"rank"`
+	Class           string   `json:"class"`
+	ProductID       uint     `json:"product_id"`
+	SKU             string   `json:"sku"`
+	Name            string   `json:"name"`
+	CategoryID      uint     `json:"category_id,omitempty"`
+	CategoryName    string   `json:"category_name"`
+	AverageStock    Quantity `json:"average_stock" swaggertype:"number"`
+	StockValue      Amount   `json:"stock_value" swaggertype:"number"`
+	Usage           Quantity `json:"usage" swaggertype:"number"`
+	UsageValue      Amount   `json:"usage_value" swaggertype:"number"`
+	Turnover        *float64 `json:"turnover,omitempty"`
+	Share           float64  `json:"share"`
+	CumulativeShare float64  `json:"cumulative_share"`
+}
+
+// ABCClassTotal is the DTO for the products and value in one ABC class
+type ABCClassTotal struct {
+	Products int     `json:"products"`
+	Value    Amount  `json:"value" swaggertype:"number"`
+	Share    float64 `json:"share"`
+}
+
+// ABCReport is the DTO for the ABC analysis. It covers the products costed
+// in Currency, ranked by Basis, highest first; those making up the first
+// AShare percent of the total are class A, up to BShare percent class B and
+// the rest class C.
+type ABCReport struct {
+	Basis    string                   `json:"basis"`
+	Currency string                   `json:"currency"`
+	From     time.Time                `json:"from"`
+	To       time.Time                `json:"to"`
+	AShare   float64                  `json:"a_share"`
+	BShare   float64                  `json:"b_share"`
+	Total    Amount                   `json:"total" swaggertype:"number"`
+	Classes  map[string]ABCClassTotal `json:"classes"`
+	Lines    []ABCLine                `json:"lines"`
+}
+
+// ABCQuery is the DTO for ABC analysis query parameters. Shares are
+// cumulative percentages of the basis total; Currency defaults to
+// DefaultCurrency.
+type ABCQuery struct {
+	Basis      string  `form:"basis" binding:"omitempty,oneof=value turnover"`
+	Currency   string  `form:"currency" binding:"omitempty,iso4217"`
+	WindowDays int     `form:"window_days" binding:"omitempty,min=1,max=730"`
+	AShare     float64 `form:"a_share" binding:"omitempty,gt=0,lt=100"`
+	BShare     float64 `form:"b_share" binding:"omitempty,gt=0,lt=100"`
+	CategoryID uint    `form:"category_id"`
+	Format     string  `form:"format" binding:"omitempty,oneof=json csv"`
+}
+
+// DeadStockLine is the DTO for a product holding stock that has not moved.
+// LastMovementAt and DaysIdle are omitted for products that never moved.
+type DeadStockLine struct {
+	ProductID      uint       `json:"product_id"`
+	SKU            string     `json:"sku"`
+	Name           string     `json:"name"`
+	CategoryID     uint       `json:"category_id,omitempty"`
+	CategoryName   string     `json:"category_name"`
+	Stock          Quantity   `json:"stock" swaggertype:"number"`
+	Currency       string     `json:"currency"`
+	AverageCost    Cost       `json:"average_cost" swaggertype:"number"`
+	StockValue     Amount     `json:"stock_value" swaggertype:"number"`
+	LastMovementAt *time.Time `json:"last_movement_at,omitempty"`
+	DaysIdle       *int       `json:"days_idle,omitempty"`
+}
+
+// DeadStockReport is the DTO for the dead-stock report: products holding
+// stock with no movement since Since, by stock value, highest first, with
+// Values the stock at average cost per product currency
+type DeadStockReport struct {
+	Days     int             `json:"days"`
+	Since    time.Time       `json:"since"`
+	Products int             `json:"products"`
+	Values   []CurrencyValue `json:"values"`
+	Lines    []DeadStockLine `json:"lines"`
+}
+
+// DeadStockQuery is the DTO for dead-stock report query parameters
+type DeadStockQuery struct {
+	Days       int    `form:"days" binding:"omitempty,min=1,max=3650"`
+	CategoryID uint   `form:"category_id"`
+	Format     string `form:"format" binding:"omitempty,oneof=json csv"`
+}
+
+// SnapshotLine is the DTO for a product's stock and price as of a snapshot,
+// taken from its latest history row at or before it
+type SnapshotLine struct {
+	ProductID    uint      `json:"product_id"`
+	SKU          string    `json:"sku"`
+	Name         string    `json:"name"`
+	CategoryID   uint      `json:"category_id,omitempty"`
+	CategoryName string    `json:"category_name,omitempty"`
+	Stock        Quantity  `json:"stock" swaggertype:"number"`
+	Price        Amount    `json:"price" swaggertype:"number"`
+	Currency     string    `json:"currency"`
+	ChangedAt    time.Time `json:"changed_at"`
+}
+
+// SnapshotReport is the DTO for the point-in-time inventory snapshot. It
+// lists the products holding stock of their own that had history by At,
+// deleted ones included if they still existed then, with Values the stock
+// at list price per currency. Bundles are left out so their components'
+// units are not counted twice.
+type SnapshotReport struct {
+	At       time.Time       `json:"at"`
+	Products int             `json:"products"`
+	Units    Quantity        `json:"units" swaggertype:"number"`
+	Values   []CurrencyValue `json:"values"`
+	Lines    []SnapshotLine  `json:"lines"`
+}
+
+// SnapshotQuery is the DTO for snapshot report query parameters
+type SnapshotQuery struct {
+	At         string `form:"at" binding:"required"` // Format: YYYY-MM-DD (end of day) or RFC3339
+	CategoryID uint   `form:"category_id"`
+	Format     string `form:"format" binding:"omitempty,oneof=json csv"`
+}
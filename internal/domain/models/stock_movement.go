@@ -0,0 +1,155 @@
+package models
+
+import (
+	"time"
+)
+
+// MovementType describes why stock changed
+type MovementType string
+
+const (
+	MovementReceipt     MovementType = "receipt"
+	MovementSale        MovementType = "sale"
+	MovementAdjustment  MovementType = "adjustment"
+	MovementDamage      MovementType = "damage"
+	MovementReturn      MovementType = "return"
+	MovementTransferIn  MovementType = "transfer_in"
+	MovementTransferOut MovementType = "transfer_out"
+	MovementConsumption MovementType = "consumption"
+	MovementProduction  MovementType = "production"
+)
+
+// Direction returns 1 for types that add stock, -1 for types that remove
+// it and 0 for types that may go either way
+func (t MovementType) Direction() int {
+	switch t {
+	case MovementReceipt, MovementReturn, MovementTransferIn, MovementProduction:
+		return 1
+	case MovementSale, MovementDamage, MovementTransferOut, MovementConsumption:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// StockMovement is a ledger entry recording a signed stock change at a warehouse
+type StockMovement struct {
+	ID          uint         `gorm:"primaryKey" json:"id"`
+	ProductID   uint         `gorm:"not null;index" json:"product_id"`
+	Product     Product      `gorm:"foreignKey:ProductID" json:"-"`
+	WarehouseID uint         `gorm:"not null;index" json:"warehouse_id"`
+	Warehouse   Warehouse    `gorm:"foreignKey:WarehouseID" json:"-"`
+	Type        MovementType `gorm:"type:varchar(20);not null;index" json:"type"`
+	Quantity    Quantity     `gorm:"not null;type:decimal(14,3)" json:"quantity" swaggertype:"number"`
+	StockAfter  Quantity     `gorm:"not null;type:decimal(14,3)" json:"stock_after" swaggertype:"number"`
+	UnitCost    *Cost        `gorm:"type:decimal(12,4)" json:"unit_cost,omitempty" swaggertype:"number"`
+	AverageCost Cost         `gorm:"not null;type:decimal(12,4);default:0" json:"average_cost" swaggertype:"number"`
+	Reason      string       `gorm:"size:500" json:"reason"`
+	Reference   string       `gorm:"size:100;index" json:"reference"`
+	UserID      *uint        `gorm:"index" json:"user_id,omitempty"`
+	CreatedAt   time.Time    `gorm:"index" json:"created_at"`
+
+	// Lots lists the lots of a lot-tracked product the movement touched.
+	// Inbound movements set Lot.LotNumber and dates for the lots to fill.
+	Lots []StockMovementLot `gorm:"foreignKey:MovementID" json:"lots,omitempty"`
+
+	// Serials lists the units of a serialized product the movement moved
+	Serials []StockMovementSerial `gorm:"foreignKey:MovementID" json:"serials,omitempty"`
+
+	// A movement of a bundle moves its components, each with a movement of
+	// its own whose ParentID points back at the bundle's movement
+	ParentID   *uint           `gorm:"index" json:"parent_id,omitempty"`
+	Components []StockMovement `gorm:"foreignKey:ParentID" json:"components,omitempty"`
+}
+
+// TableName specifies the table name for StockMovement model
+func (StockMovement) TableName() string {
+	return "stock_movements"
+}
+
+// StockMovementResponse is the DTO for stock movement responses
+type StockMovementResponse struct {
+	ID          uint                       `json:"id"`
+	ProductID   uint                       `json:"product_id"`
+	WarehouseID uint                       `json:"warehouse_id"`
+	Type        MovementType               `json:"type"`
+	Quantity    Quantity                   `json:"quantity" swaggertype:"number"`
+	StockAfter  Quantity                   `json:"stock_after" swaggertype:"number"`
+	UnitCost    *Cost                      `json:"unit_cost,omitempty" swaggertype:"number"`
+	AverageCost Cost                       `json:"average_cost" swaggertype:"number"`
+	Reason      string                     `json:"reason"`
+	Reference   string                     `json:"reference"`
+	UserID      *uint                      `json:"user_id,omitempty"`
+	Lots        []StockMovementLotResponse `json:"lots,omitempty"`
+	Serials     []string                   `json:"serials,omitempty"`
+	ParentID    *uint                      `json:"parent_id,omitempty"`
+	Components  []StockMovementResponse    `json:"components,omitempty"`
+	InUnit      *MovementUnitQuantities    `json:"in_unit,omitempty"`
+	CreatedAt   time.Time                  `json:"created_at"`
+}
+
+// ToResponse converts StockMovement to StockMovementResponse
+func (m *StockMovement) ToResponse() StockMovementResponse {
+	response := StockMovementResponse{
+		ID:          m.ID,
+		ProductID:   m.ProductID,
+		WarehouseID: m.WarehouseID,
+		Type:        m.Type,
+		Quantity:    m.Quantity,
+		StockAfter:  m.StockAfter,
+		UnitCost:    m.UnitCost,
+		AverageCost: m.AverageCost,
+		Reason:      m.Reason,
+		Reference:   m.Reference,
+		UserID:      m.UserID,
+		ParentID:    m.ParentID,
+		CreatedAt:   m.CreatedAt,
+	}
+	if len(m.Lots) > 0 {
+		response.Lots = make([]StockMovementLotResponse, len(m.Lots))
+		for i, lot := range m.Lots {
+			response.Lots[i] = lot.ToResponse()
+		}
+	}
+	if len(m.Serials) > 0 {
+		response.Serials = make([]string, len(m.Serials))
+		for i, serial := range m.Serials {
+			response.Serials[i] = serial.SerialNumber.Serial
+		}
+	}
+	if len(m.Components) > 0 {
+		response.Components = make([]StockMovementResponse, len(m.Components))
+		for i, component := range m.Components {
+			response.Components[i] = component.ToResponse()
+		}
+	}
+	return response
+}
+
+// CreateStockMovementRequest is the DTO for recording a stock movement.
+// Quantity is a signed delta in Unit, or the base unit if empty; its sign
+// must match the movement type. UnitCost is the cost per Unit of inbound
+// stock, in the product's currency, and defaults to the product's average cost; it is ignored for
+// outbound movements.
+// Transfer movements are only created by transfers, and consumption and
+// production movements by work orders.
+type CreateStockMovementRequest struct {
+	Type        MovementType `json:"type" binding:"required,oneof=receipt sale adjustment damage return"`
+	Quantity    Quantity     `json:"quantity" binding:"required" swaggertype:"number"`
+	WarehouseID uint         `json:"warehouse_id"`
+	Reason      string       `json:"reason" binding:"max=500"`
+	Reference   string       `json:"reference" binding:"max=100"`
+	Unit        string       `json:"unit" binding:"max=20"`
+	UnitCost    *Cost        `json:"unit_cost" binding:"omitempty,gte=0" swaggertype:"number"`
+	LotInput
+	SerialInput
+}
+
+// StockMovementQuery is the DTO for movement listing query parameters
+type StockMovementQuery struct {
+	PaginationRequest
+	Type  string `form:"type" binding:"omitempty,oneof=receipt sale adjustment damage return transfer_in transfer_out consumption production"`
+	Start string `form:"start"` // Format: YYYY-MM-DD or RFC3339
+	End   string `form:"end"`   // Format: YYYY-MM-DD or RFC3339
+	Unit  string `form:"unit" binding:"max=20"`
+}
@@ -0,0 +1,192 @@
+package models
+
+import (
+	"time"
+)
+
+// StocktakeStatus is the lifecycle state of a stocktake
+type StocktakeStatus string
+
+const (
+	StocktakeOpen     StocktakeStatus = "open"
+	StocktakeApproved StocktakeStatus = "approved"
+	StocktakeRejected StocktakeStatus = "rejected"
+)
+
+// Stocktake is a count session over the products of a category, a
+// warehouse, or a category at a warehouse. Its lines are fixed when it is
+// opened, and a category alone is counted at the default warehouse. Counts
+// record the variance against the stock at the warehouse at the time of the
+// count; approving posts each variance as an adjustment there.
+type Stocktake struct {
+	ID              uint            `gorm:"primaryKey" json:"id"`
+	Number          string          `gorm:"size:20;index" json:"number"`
+	WarehouseID     *uint           `gorm:"index" json:"warehouse_id,omitempty"`
+	Warehouse       *Warehouse      `gorm:"foreignKey:WarehouseID" json:"-"`
+	CategoryID      *uint           `gorm:"index" json:"category_id,omitempty"`
+	Category        *Category       `gorm:"foreignKey:CategoryID" json:"-"`
+	Status          StocktakeStatus `gorm:"type:varchar(20);not null;default:'open';index" json:"status"`
+	Notes           string          `gorm:"size:1000" json:"notes"`
+	RejectionReason string          `gorm:"size:1000" json:"rejection_reason,omitempty"`
+	UserID          *uint           `gorm:"index" json:"user_id,omitempty"`
+	ReviewedBy      *uint           `json:"reviewed_by,omitempty"`
+	ReviewedAt      *time.Time      `json:"reviewed_at,omitempty"`
+	Lines           []StocktakeLine `gorm:"foreignKey:StocktakeID" json:"lines"`
+	CreatedAt       time.Time       `json:"created_at"`
+	UpdatedAt       time.Time       `json:"updated_at"`
+}
+
+// TableName specifies the table name for Stocktake model
+func (Stocktake) TableName() string {
+	return "stocktakes"
+}
+
+// StocktakeLine is a product to be counted by a stocktake. Counted and
+// Expected are set together when a count is submitted; MovementID links the
+// line to the adjustment posted for its variance on approval.
+type StocktakeLine struct {
+	ID          uint       `gorm:"primaryKey" json:"id"`
+	StocktakeID uint       `gorm:"not null;index" json:"stocktake_id"`
+	ProductID   uint       `gorm:"not null;index" json:"product_id"`
+	Product     Product    `gorm:"foreignKey:ProductID" json:"-"`
+	Counted     *Quantity  `gorm:"type:decimal(14,3)" json:"counted,omitempty" swaggertype:"number"`
+	Expected    *Quantity  `gorm:"type:decimal(14,3)" json:"expected,omitempty" swaggertype:"number"`
+	CountedBy   *uint      `json:"counted_by,omitempty"`
+	CountedAt   *time.Time `json:"counted_at,omitempty"`
+	MovementID  *uint      `json:"movement_id,omitempty"`
+}
+
+// TableName specifies the table name for StocktakeLine model
+func (StocktakeLine) TableName() string {
+	return "stocktake_lines"
+}
+
+// Variance returns the counted quantity less the expected one, or zero if
+// the line has not been counted
+func (l *StocktakeLine) Variance() Quantity {
+	if l.Counted == nil || l.Expected == nil {
+		return 0
+	}
+	return *l.Counted - *l.Expected
+}
+
+// StocktakeResponse is the DTO for stocktake responses
+type StocktakeResponse struct {
+	ID                uint                    `json:"id"`
+	Number            string                  `json:"number"`
+	WarehouseID       *uint                   `json:"warehouse_id,omitempty"`
+	WarehouseCode     string                  `json:"warehouse_code,omitempty"`
+	CategoryID        *uint                   `json:"category_id,omitempty"`
+	CategoryName      string                  `json:"category_name,omitempty"`
+	Status            StocktakeStatus         `json:"status"`
+	Notes             string                  `json:"notes"`
+	RejectionReason   string                  `json:"rejection_reason,omitempty"`
+	LinesCounted      int                     `json:"lines_counted"`
+	LinesWithVariance int                     `json:"lines_with_variance"`
+	UserID            *uint                   `json:"user_id,omitempty"`
+	ReviewedBy        *uint                   `json:"reviewed_by,omitempty"`
+	ReviewedAt        *time.Time              `json:"reviewed_at,omitempty"`
+	Lines             []StocktakeLineResponse `json:"lines"`
+	CreatedAt         time.Time               `json:"created_at"`
+	UpdatedAt         time.Time               `json:"updated_at"`
+}
+
+// StocktakeLineResponse is the DTO for stocktake line responses. Variance is
+// only set once the line is counted.
+type StocktakeLineResponse struct {
+	ID          uint       `json:"id"`
+	ProductID   uint       `json:"product_id"`
+	ProductName string     `json:"product_name,omitempty"`
+	SKU         string     `json:"sku,omitempty"`
+	Counted     *Quantity  `json:"counted,omitempty" swaggertype:"number"`
+	Expected    *Quantity  `json:"expected,omitempty" swaggertype:"number"`
+	Variance    *Quantity  `json:"variance,omitempty" swaggertype:"number"`
+	CountedBy   *uint      `json:"counted_by,omitempty"`
+	CountedAt   *time.Time `json:"counted_at,omitempty"`
+	MovementID  *uint      `json:"movement_id,omitempty"`
+}
+
+// ToResponse converts Stocktake to StocktakeResponse
+func (s *Stocktake) ToResponse() StocktakeResponse {
+	response := StocktakeResponse{
+		ID:              s.ID,
+		Number:          s.Number,
+		WarehouseID:     s.WarehouseID,
+		CategoryID:      s.CategoryID,
+		Status:          s.Status,
+		Notes:           s.Notes,
+		RejectionReason: s.RejectionReason,
+		UserID:          s.UserID,
+		ReviewedBy:      s.ReviewedBy,
+		ReviewedAt:      s.ReviewedAt,
+		Lines:           make([]StocktakeLineResponse, len(s.Lines)),
+		CreatedAt:       s.CreatedAt,
+		UpdatedAt:       s.UpdatedAt,
+	}
+	if s.Warehouse != nil {
+		response.WarehouseCode = s.Warehouse.Code
+	}
+	if s.Category != nil {
+		response.CategoryName = s.Category.Name
+	}
+
+	for i, line := range s.Lines {
+		response.Lines[i] = StocktakeLineResponse{
+			ID:          line.ID,
+			ProductID:   line.ProductID,
+			ProductName: line.Product.Name,
+			SKU:         line.Product.SKU,
+			Counted:     line.Counted,
+			Expected:    line.Expected,
+			CountedBy:   line.CountedBy,
+			CountedAt:   line.CountedAt,
+			MovementID:  line.MovementID,
+		}
+		if line.Counted != nil {
+			variance := line.Variance()
+			response.Lines[i].Variance = &variance
+			response.LinesCounted++
+			if variance != 0 {
+				response.LinesWithVariance++
+			}
+		}
+	}
+
+	return response
+}
+
+// CreateStocktakeRequest is the DTO for opening a stocktake. At least one of
+// WarehouseID and CategoryID is required; WarehouseID defaults to the
+// default warehouse.
+type CreateStocktakeRequest struct {
+	WarehouseID uint   `json:"warehouse_id" binding:"required_without=CategoryID"`
+	CategoryID  uint   `json:"category_id" binding:"required_without=WarehouseID"`
+	Notes       string `json:"notes" binding:"max=1000"`
+}
+
+// StocktakeCountRequest is the DTO for the counted quantity of one product.
+// Counted is in Unit, one of the product's units, or the base unit if empty.
+type StocktakeCountRequest struct {
+	ProductID uint      `json:"product_id" binding:"required"`
+	Counted   *Quantity `json:"counted" binding:"required,gte=0" swaggertype:"number"`
+	Unit      string    `json:"unit" binding:"max=20"`
+}
+
+// SubmitStocktakeCountsRequest is the DTO for submitting counts to an open
+// stocktake. Counting a product again replaces its earlier count.
+type SubmitStocktakeCountsRequest struct {
+	Counts []StocktakeCountRequest `json:"counts" binding:"required,min=1,max=500,dive"`
+}
+
+// RejectStocktakeRequest is the DTO for rejecting a stocktake
+type RejectStocktakeRequest struct {
+	Reason string `json:"reason" binding:"max=1000"`
+}
+
+// StocktakeListQuery is the DTO for stocktake listing query parameters
+type StocktakeListQuery struct {
+	PaginationRequest
+	WarehouseID uint   `form:"warehouse_id"`
+	CategoryID  uint   `form:"category_id"`
+	Status      string `form:"status" binding:"omitempty,oneof=open approved rejected"`
+}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Supplier is a vendor that stock is purchased from
+type Supplier struct {
+	ID          uint           `gorm:"primaryKey" json:"id"`
+	Name        string         `gorm:"uniqueIndex;not null;size:200" json:"name"`
+	ContactName string         `gorm:"size:100" json:"contact_name"`
+	Email       string         `gorm:"size:255" json:"email"`
+	Phone       string         `gorm:"size:50" json:"phone"`
+	Address     string         `gorm:"size:500" json:"address"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+// TableName specifies the table name for Supplier model
+func (Supplier) TableName() string {
+	return "suppliers"
+}
+
+// SupplierResponse is the DTO for supplier responses
+type SupplierResponse struct {
+	ID          uint      `json:"id"`
+	Name        string    `json:"name"`
+	ContactName string    `json:"contact_name"`
+	Email       string    `json:"email"`
+	Phone       string    `json:"phone"`
+	Address     string    `json:"address"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ToResponse converts Supplier to SupplierResponse
+func (s *Supplier) ToResponse() SupplierResponse {
+	return SupplierResponse{
+		ID:          s.ID,
+		Name:        s.Name,
+		ContactName: s.ContactName,
+		Email:       s.Email,
+		Phone:       s.Phone,
+		Address:     s.Address,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
+
+// CreateSupplierRequest is the DTO for creating a supplier
+type CreateSupplierRequest struct {
+	Name        string `json:"name" binding:"required,min=1,max=200"`
+	ContactName string `json:"contact_name" binding:"max=100"`
+	Email       string `json:"email" binding:"omitempty,email,max=255"`
+	Phone       string `json:"phone" binding:"max=50"`
+	Address     string `json:"address" binding:"max=500"`
+}
+
+// UpdateSupplierRequest is the DTO for updating a supplier
+type UpdateSupplierRequest struct {
+	Name        string `json:"name" binding:"omitempty,min=1,max=200"`
+	ContactName string `json:"contact_name" binding:"max=100"`
+	Email       string `json:"email" binding:"omitempty,email,max=255"`
+	Phone       string `json:"phone" binding:"max=50"`
+	Address     string `json:"address" binding:"max=500"`
+}
+
+// SupplierListQuery is the DTO for supplier listing query parameters
+type SupplierListQuery struct {
+	PaginationRequest
+	Search string `form:"search"`
+}
@@ -0,0 +1,162 @@
+package models
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// TaxClass groups products taxed alike, such as standard or reduced rate
+// goods. It has one rate per jurisdiction it applies in.
+type TaxClass struct {
+	ID          uint           `gorm:"primaryKey" json:"id"`
+	Code        string         `gorm:"uniqueIndex;not null;size:50" json:"code"`
+	Name        string         `gorm:"not null;size:200" json:"name"`
+	Description string         `gorm:"size:500" json:"description"`
+	Rates       []TaxRate      `gorm:"foreignKey:TaxClassID" json:"rates,omitempty"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+	DeletedAt   gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+// TableName specifies the table name for TaxClass model
+func (TaxClass) TableName() string {
+	return "tax_classes"
+}
+
+// TaxRate is a tax class's rate in a jurisdiction, a percentage of the net
+// price. Jurisdictions are uppercase codes such as DE or US-CA.
+type TaxRate struct {
+	ID           uint      `gorm:"primaryKey" json:"id"`
+	TaxClassID   uint      `gorm:"not null;uniqueIndex:idx_tax_rates_jurisdiction" json:"tax_class_id"`
+	TaxClass     *TaxClass `gorm:"foreignKey:TaxClassID" json:"-"`
+	Jurisdiction string    `gorm:"not null;size:20;uniqueIndex:idx_tax_rates_jurisdiction;index" json:"jurisdiction"`
+	Name         string    `gorm:"size:100" json:"name"`
+	Rate         float64   `gorm:"not null;type:decimal(7,4)" json:"rate"`
+}
+
+// TableName specifies the table name for TaxRate model
+func (TaxRate) TableName() string {
+	return "tax_rates"
+}
+
+// NormalizeJurisdiction returns the form jurisdictions are stored and
+// looked up in
+func NormalizeJurisdiction(jurisdiction string) string {
+	return strings.ToUpper(strings.TrimSpace(jurisdiction))
+}
+
+// TaxClassResponse is the DTO for tax class responses. Rates are only
+// included for a single tax class.
+type TaxClassResponse struct {
+	ID          uint              `json:"id"`
+	Code        string            `json:"code"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Rates       []TaxRateResponse `json:"rates,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+// TaxRateResponse is the DTO for a tax class's rate in a jurisdiction
+type TaxRateResponse struct {
+	Jurisdiction string  `json:"jurisdiction"`
+	Name         string  `json:"name"`
+	Rate         float64 `json:"rate"`
+}
+
+// ToResponse converts TaxClass to TaxClassResponse
+func (t *TaxClass) ToResponse() TaxClassResponse {
+	response := TaxClassResponse{
+		ID:          t.ID,
+		Code:        t.Code,
+		Name:        t.Name,
+		Description: t.Description,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+
+	for _, rate := range t.Rates {
+		response.Rates = append(response.Rates, TaxRateResponse{
+			Jurisdiction: rate.Jurisdiction,
+			Name:         rate.Name,
+			Rate:         rate.Rate,
+		})
+	}
+
+	return response
+}
+
+// ProductTax is the DTO for a product's price split into net, tax and gross
+// in a jurisdiction. Prices are stored net of tax. TaxClass and Rate are
+// empty when the product has no tax class or its class has no rate there,
+// in which case no tax is charged.
+type ProductTax struct {
+	Jurisdiction string  `json:"jurisdiction"`
+	TaxClass     string  `json:"tax_class,omitempty"`
+	RateName     string  `json:"rate_name,omitempty"`
+	Rate         float64 `json:"rate"`
+	Net          Amount  `json:"net" swaggertype:"number"`
+	Tax          Amount  `json:"tax" swaggertype:"number"`
+	Gross        Amount  `json:"gross" swaggertype:"number"`
+	Currency     string  `json:"currency"`
+}
+
+// ApplyTax splits the product's price into net, tax and gross at the given
+// rate, which may be nil when no tax applies
+func (p *Product) ApplyTax(jurisdiction string, rate *TaxRate) ProductTax {
+	tax := ProductTax{
+		Jurisdiction: jurisdiction,
+		Net:          p.Price,
+		Currency:     p.Currency,
+	}
+	if rate != nil {
+		if rate.TaxClass != nil {
+			tax.TaxClass = rate.TaxClass.Code
+		}
+		tax.RateName = rate.Name
+		tax.Rate = rate.Rate
+		tax.Tax = p.Price.Percent(rate.Rate)
+	}
+	tax.Gross = tax.Net + tax.Tax
+	return tax
+}
+
+// CreateTaxClassRequest is the DTO for creating a tax class
+type CreateTaxClassRequest struct {
+	Code        string `json:"code" binding:"required,min=1,max=50"`
+	Name        string `json:"name" binding:"required,min=1,max=200"`
+	Description string `json:"description" binding:"max=500"`
+}
+
+// UpdateTaxClassRequest is the DTO for updating a tax class
+type UpdateTaxClassRequest struct {
+	Code        string `json:"code" binding:"omitempty,min=1,max=50"`
+	Name        string `json:"name" binding:"omitempty,min=1,max=200"`
+	Description string `json:"description" binding:"max=500"`
+}
+
+// TaxRateRequest is the DTO for a tax class's rate in a jurisdiction. Rate
+// is a percentage of the net price.
+type TaxRateRequest struct {
+	Jurisdiction string  `json:"jurisdiction" binding:"required,min=2,max=20"`
+	Name         string  `json:"name" binding:"max=100"`
+	Rate         float64 `json:"rate" binding:"gte=0,lte=100"`
+}
+
+// SetTaxRatesRequest is the DTO for replacing a tax class's rates
+type SetTaxRatesRequest struct {
+	Rates []TaxRateRequest `json:"rates" binding:"max=500,dive"`
+}
+
+// TaxQuery is the DTO for the jurisdiction product prices are taxed in
+type TaxQuery struct {
+	TaxJurisdiction string `form:"tax_jurisdiction" binding:"max=20"`
+}
+
+// TaxClassQuery is the DTO for tax class listing query parameters
+type TaxClassQuery struct {
+	PaginationRequest
+	Search string `form:"search"`
+}
@@ -0,0 +1,149 @@
+package models
+
+import (
+	"time"
+)
+
+// TransferStatus is the lifecycle state of a stock transfer
+type TransferStatus string
+
+const (
+	TransferDraft     TransferStatus = "draft"
+	TransferInTransit TransferStatus = "in_transit"
+	TransferReceived  TransferStatus = "received"
+	TransferCancelled TransferStatus = "cancelled"
+)
+
+// Transfer moves stock from one warehouse to another. Dispatching removes
+// the stock from the source and receiving adds it at the destination; in
+// between it is in transit and counts towards neither location.
+type Transfer struct {
+	ID                     uint           `gorm:"primaryKey" json:"id"`
+	Number                 string         `gorm:"size:20;index" json:"number"`
+	SourceWarehouseID      uint           `gorm:"not null;index" json:"source_warehouse_id"`
+	SourceWarehouse        Warehouse      `gorm:"foreignKey:SourceWarehouseID" json:"-"`
+	DestinationWarehouseID uint           `gorm:"not null;index" json:"destination_warehouse_id"`
+	DestinationWarehouse   Warehouse      `gorm:"foreignKey:DestinationWarehouseID" json:"-"`
+	Status                 TransferStatus `gorm:"type:varchar(20);not null;default:'draft';index" json:"status"`
+	Notes                  string         `gorm:"size:1000" json:"notes"`
+	DispatchedAt           *time.Time     `json:"dispatched_at,omitempty"`
+	ReceivedAt             *time.Time     `json:"received_at,omitempty"`
+	CancelledAt            *time.Time     `json:"cancelled_at,omitempty"`
+	UserID                 *uint          `gorm:"index" json:"user_id,omitempty"`
+	Lines                  []TransferLine `gorm:"foreignKey:TransferID" json:"lines"`
+	CreatedAt              time.Time      `json:"created_at"`
+	UpdatedAt              time.Time      `json:"updated_at"`
+}
+
+// TableName specifies the table name for Transfer model
+func (Transfer) TableName() string {
+	return "transfers"
+}
+
+// TransferLine is a product and quantity moved by a transfer.
+// DispatchMovementID links the line to the movement that took it out of the
+// source, whose lots are recreated at the destination on receipt.
+type TransferLine struct {
+	ID                 uint     `gorm:"primaryKey" json:"id"`
+	TransferID         uint     `gorm:"not null;index" json:"transfer_id"`
+	ProductID          uint     `gorm:"not null;index" json:"product_id"`
+	Product            Product  `gorm:"foreignKey:ProductID" json:"-"`
+	Quantity           Quantity `gorm:"not null;type:decimal(14,3)" json:"quantity" swaggertype:"number"`
+	DispatchMovementID *uint    `json:"dispatch_movement_id,omitempty"`
+}
+
+// TableName specifies the table name for TransferLine model
+func (TransferLine) TableName() string {
+	return "transfer_lines"
+}
+
+// TransferResponse is the DTO for transfer responses
+type TransferResponse struct {
+	ID                       uint                   `json:"id"`
+	Number                   string                 `json:"number"`
+	SourceWarehouseID        uint                   `json:"source_warehouse_id"`
+	SourceWarehouseCode      string                 `json:"source_warehouse_code,omitempty"`
+	DestinationWarehouseID   uint                   `json:"destination_warehouse_id"`
+	DestinationWarehouseCode string                 `json:"destination_warehouse_code,omitempty"`
+	Status                   TransferStatus         `json:"status"`
+	Notes                    string                 `json:"notes"`
+	DispatchedAt             *time.Time             `json:"dispatched_at,omitempty"`
+	ReceivedAt               *time.Time             `json:"received_at,omitempty"`
+	CancelledAt              *time.Time             `json:"cancelled_at,omitempty"`
+	UserID                   *uint                  `json:"user_id,omitempty"`
+	Lines                    []TransferLineResponse `json:"lines"`
+	CreatedAt                time.Time              `json:"created_at"`
+	UpdatedAt                time.Time              `json:"updated_at"`
+}
+
+// TransferLineResponse is the DTO for transfer line responses
+type TransferLineResponse struct {
+	ID          uint     `json:"id"`
+	ProductID   uint     `json:"product_id"`
+	ProductName string   `json:"product_name,omitempty"`
+	SKU         string   `json:"sku,omitempty"`
+	Quantity    Quantity `json:"quantity" swaggertype:"number"`
+}
+
+// ToResponse converts Transfer to TransferResponse
+func (t *Transfer) ToResponse() TransferResponse {
+	response := TransferResponse{
+		ID:                       t.ID,
+		Number:                   t.Number,
+		SourceWarehouseID:        t.SourceWarehouseID,
+		SourceWarehouseCode:      t.SourceWarehouse.Code,
+		DestinationWarehouseID:   t.DestinationWarehouseID,
+		DestinationWarehouseCode: t.DestinationWarehouse.Code,
+		Status:                   t.Status,
+		Notes:                    t.Notes,
+		DispatchedAt:             t.DispatchedAt,
+		ReceivedAt:               t.ReceivedAt,
+		CancelledAt:              t.CancelledAt,
+		UserID:                   t.UserID,
+		Lines:                    make([]TransferLineResponse, len(t.Lines)),
+		CreatedAt:                t.CreatedAt,
+		UpdatedAt:                t.UpdatedAt,
+	}
+
+	for i, line := range t.Lines {
+		response.Lines[i] = TransferLineResponse{
+			ID:          line.ID,
+			ProductID:   line.ProductID,
+			ProductName: line.Product.Name,
+			SKU:         line.Product.SKU,
+			Quantity:    line.Quantity,
+		}
+	}
+
+	return response
+}
+
+// TransferLineRequest is the DTO for a line on a transfer
+type TransferLineRequest struct {
+	ProductID uint     `json:"product_id" binding:"required"`
+	Quantity  Quantity `json:"quantity" binding:"required,gt=0" swaggertype:"number"`
+	Unit      string   `json:"unit" binding:"max=20"`
+}
+
+// CreateTransferRequest is the DTO for creating a draft transfer
+type CreateTransferRequest struct {
+	SourceWarehouseID      uint                  `json:"source_warehouse_id" binding:"required"`
+	DestinationWarehouseID uint                  `json:"destination_warehouse_id" binding:"required,nefield=SourceWarehouseID"`
+	Notes                  string                `json:"notes" binding:"max=1000"`
+	Lines                  []TransferLineRequest `json:"lines" binding:"required,min=1,dive"`
+}
+
+// DispatchTransferRequest is the DTO for dispatching a transfer. Lines of
+// serialized products must list the units sent; they are received as the
+// same units.
+type DispatchTransferRequest struct {
+	Lines []LineSerialsRequest `json:"lines" binding:"omitempty,dive"`
+}
+
+// TransferListQuery is the DTO for transfer listing query parameters.
+// WarehouseID matches transfers leaving from or arriving at the warehouse.
+type TransferListQuery struct {
+	PaginationRequest
+	WarehouseID uint   `form:"warehouse_id"`
+	Status      string `form:"status" binding:"omitempty,oneof=draft in_transit received cancelled"`
+}
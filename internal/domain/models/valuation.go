@@ -0,0 +1,67 @@
+package models
+
+import (
+	"time"
+)
+
+// Costing methods for valuing stock and the cost of goods sold
+const (
+	CostingFIFO    = "fifo"
+	CostingAverage = "average"
+)
+
+// ProductValuation is the stock a product held at a point in time, what it
+// cost, and the cost of the goods it sold over a period, in the product's
+// currency
+type ProductValuation struct {
+	ProductID       uint
+	CategoryID      uint
+	CategoryName    string
+	Currency        string
+	Quantity        Quantity
+	Value           Amount
+	CostOfGoodsSold Amount
+}
+
+// CategoryValuation is the DTO for the valuation of one category's products
+// costed in one currency
+type CategoryValuation struct {
+	CategoryID      uint     `json:"category_id"`
+	CategoryName    string   `json:"category_name"`
+	Currency        string   `json:"currency"`
+	Products        int      `json:"products"`
+	Quantity        Quantity `json:"quantity" swaggertype:"number"`
+	Value           Amount   `json:"value" swaggertype:"number"`
+	CostOfGoodsSold Amount   `json:"cost_of_goods_sold" swaggertype:"number"`
+}
+
+// ValuationTotal is the DTO for the valuation of the products costed in one
+// currency
+type ValuationTotal struct {
+	Currency        string `json:"currency"`
+	Value           Amount `json:"value" swaggertype:"number"`
+	CostOfGoodsSold Amount `json:"cost_of_goods_sold" swaggertype:"number"`
+}
+
+// ValuationReport is the DTO for the inventory valuation report. Value is
+// the cost of the stock held at AsOf; CostOfGoodsSold is the cost of sales
+// less returns from From up to AsOf. Products are costed in their own
+// currency, so categories and totals are per currency.
+type ValuationReport struct {
+	Method     string              `json:"method"`
+	From       time.Time           `json:"from"`
+	AsOf       time.Time           `json:"as_of"`
+	Categories []CategoryValuation `json:"categories"`
+	Quantity   Quantity            `json:"quantity" swaggertype:"number"`
+	Totals     []ValuationTotal    `json:"totals"`
+}
+
+// ValuationQuery is the DTO for valuation report query parameters. AsOf
+// defaults to now and From to the start of AsOf's month; Method defaults to
+// the configured costing method.
+type ValuationQuery struct {
+	AsOf       string `form:"as_of"`
+	From       string `form:"from"`
+	Method     string `form:"method" binding:"omitempty,oneof=fifo average"`
+	CategoryID uint   `form:"category_id"`
+}
@@ -0,0 +1,114 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Warehouse is a physical stock location (warehouse, store backroom, ...)
+type Warehouse struct {
+	ID        uint           `gorm:"primaryKey" json:"id"`
+	Code      string         `gorm:"uniqueIndex;not null;size:20" json:"code"`
+	Name      string         `gorm:"not null;size:100" json:"name"`
+	Address   string         `gorm:"size:500" json:"address"`
+	IsDefault bool           `gorm:"not null;default:false" json:"is_default"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index" json:"-"`
+}
+
+// TableName specifies the table name for Warehouse model
+func (Warehouse) TableName() string {
+	return "warehouses"
+}
+
+// WarehouseResponse is the DTO for warehouse responses
+type WarehouseResponse struct {
+	ID        uint      `json:"id"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	Address   string    `json:"address"`
+	IsDefault bool      `json:"is_default"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// ToResponse converts Warehouse to WarehouseResponse
+func (w *Warehouse) ToResponse() WarehouseResponse {
+	return WarehouseResponse{
+		ID:        w.ID,
+		Code:      w.Code,
+		Name:      w.Name,
+		Address:   w.Address,
+		IsDefault: w.IsDefault,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+// CreateWarehouseRequest is the DTO for creating a warehouse
+type CreateWarehouseRequest struct {
+	Code      string `json:"code" binding:"required,min=1,max=20"`
+	Name      string `json:"name" binding:"required,min=1,max=100"`
+	Address   string `json:"address" binding:"max=500"`
+	IsDefault bool   `json:"is_default"`
+}
+
+// UpdateWarehouseRequest is the DTO for updating a warehouse
+type UpdateWarehouseRequest struct {
+	Code      string `json:"code" binding:"omitempty,min=1,max=20"`
+	Name      string `json:"name" binding:"omitempty,min=1,max=100"`
+	Address   string `json:"address" binding:"max=500"`
+	IsDefault *bool  `json:"is_default"`
+}
+
+// ProductStock holds the quantity of a product at a single warehouse.
+// Product.Stock is kept equal to the sum of these rows.
+type ProductStock struct {
+	ProductID   uint      `gorm:"primaryKey" json:"product_id"`
+	WarehouseID uint      `gorm:"primaryKey;index" json:"warehouse_id"`
+	Warehouse   Warehouse `gorm:"foreignKey:WarehouseID" json:"-"`
+	Quantity    Quantity  `gorm:"not null;type:decimal(14,3);default:0" json:"quantity" swaggertype:"number"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// TableName specifies the table name for ProductStock model
+func (ProductStock) TableName() string {
+	return "product_stocks"
+}
+
+// ProductStockResponse is the DTO for per-location stock responses
+type ProductStockResponse struct {
+	ProductID     uint      `json:"product_id"`
+	WarehouseID   uint      `json:"warehouse_id"`
+	WarehouseCode string    `json:"warehouse_code,omitempty"`
+	WarehouseName string    `json:"warehouse_name,omitempty"`
+	Quantity      Quantity  `json:"quantity" swaggertype:"number"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// ToResponse converts ProductStock to ProductStockResponse
+func (s *ProductStock) ToResponse() ProductStockResponse {
+	return ProductStockResponse{
+		ProductID:     s.ProductID,
+		WarehouseID:   s.WarehouseID,
+		WarehouseCode: s.Warehouse.Code,
+		WarehouseName: s.Warehouse.Name,
+		Quantity:      s.Quantity,
+		UpdatedAt:     s.UpdatedAt,
+	}
+}
+
+// UpdateLocationStockRequest is the DTO for setting stock at a warehouse
+type UpdateLocationStockRequest struct {
+	Stock Quantity `json:"stock" binding:"gte=0" swaggertype:"number"`
+	Unit  string   `json:"unit" binding:"max=20"`
+}
+
+// StockUpdatedEvent is the payload of stock.updated WebSocket events.
+// It embeds the product so existing consumers keep working.
+type StockUpdatedEvent struct {
+	ProductResponse
+	Location *ProductStockResponse `json:"location,omitempty"`
+}
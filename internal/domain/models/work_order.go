@@ -0,0 +1,222 @@
+package models
+
+import (
+	"time"
+)
+
+// WorkOrderStatus is the lifecycle state of a work order
+type WorkOrderStatus string
+
+const (
+	WorkOrderDraft      WorkOrderStatus = "draft"
+	WorkOrderInProgress WorkOrderStatus = "in_progress"
+	WorkOrderCompleted  WorkOrderStatus = "completed"
+	WorkOrderCancelled  WorkOrderStatus = "cancelled"
+)
+
+// WorkOrder assembles a finished product from component stock at a
+// warehouse. Each completion consumes the components of the units built or
+// scrapped and adds the built units to the finished product's stock.
+type WorkOrder struct {
+	ID                uint                  `gorm:"primaryKey" json:"id"`
+	Number            string                `gorm:"size:20;index" json:"number"`
+	ProductID         uint                  `gorm:"not null;index" json:"product_id"`
+	Product           Product               `gorm:"foreignKey:ProductID" json:"-"`
+	WarehouseID       uint                  `gorm:"not null;index" json:"warehouse_id"`
+	Warehouse         Warehouse             `gorm:"foreignKey:WarehouseID" json:"-"`
+	Quantity          Quantity              `gorm:"not null;type:decimal(14,3)" json:"quantity" swaggertype:"number"`
+	QuantityCompleted Quantity              `gorm:"not null;type:decimal(14,3);default:0" json:"quantity_completed" swaggertype:"number"`
+	QuantityScrapped  Quantity              `gorm:"not null;type:decimal(14,3);default:0" json:"quantity_scrapped" swaggertype:"number"`
+	Status            WorkOrderStatus       `gorm:"type:varchar(20);not null;default:'draft';index" json:"status"`
+	Notes             string                `gorm:"size:1000" json:"notes"`
+	StartedAt         *time.Time            `json:"started_at,omitempty"`
+	CompletedAt       *time.Time            `json:"completed_at,omitempty"`
+	CancelledAt       *time.Time            `json:"cancelled_at,omitempty"`
+	UserID            *uint                 `gorm:"index" json:"user_id,omitempty"`
+	Components        []WorkOrderComponent  `gorm:"foreignKey:WorkOrderID" json:"components"`
+	Completions       []WorkOrderCompletion `gorm:"foreignKey:WorkOrderID" json:"completions"`
+	CreatedAt         time.Time             `json:"created_at"`
+	UpdatedAt         time.Time             `json:"updated_at"`
+}
+
+// TableName specifies the table name for WorkOrder model
+func (WorkOrder) TableName() string {
+	return "work_orders"
+}
+
+// Outstanding returns the number of units still to be built or scrapped
+func (w *WorkOrder) Outstanding() Quantity {
+	done := w.QuantityCompleted + w.QuantityScrapped
+	if done >= w.Quantity {
+		return 0
+	}
+	return w.Quantity - done
+}
+
+// WorkOrderComponent is a component consumed by a work order, with the
+// quantity needed per finished unit
+type WorkOrderComponent struct {
+	ID               uint     `gorm:"primaryKey" json:"id"`
+	WorkOrderID      uint     `gorm:"not null;index" json:"work_order_id"`
+	ProductID        uint     `gorm:"not null;index" json:"product_id"`
+	Product          Product  `gorm:"foreignKey:ProductID" json:"-"`
+	QuantityPerUnit  Quantity `gorm:"not null;type:decimal(14,3)" json:"quantity_per_unit" swaggertype:"number"`
+	QuantityConsumed Quantity `gorm:"not null;type:decimal(14,3);default:0" json:"quantity_consumed" swaggertype:"number"`
+}
+
+// TableName specifies the table name for WorkOrderComponent model
+func (WorkOrderComponent) TableName() string {
+	return "work_order_components"
+}
+
+// WorkOrderCompletion records one completion booked against a work order.
+// MovementID links it to the production movement of the built units, if any;
+// the component movements share the work order number as their reference.
+type WorkOrderCompletion struct {
+	ID          uint      `gorm:"primaryKey" json:"id"`
+	WorkOrderID uint      `gorm:"not null;index" json:"work_order_id"`
+	Quantity    Quantity  `gorm:"not null;type:decimal(14,3)" json:"quantity" swaggertype:"number"`
+	Scrapped    Quantity  `gorm:"not null;type:decimal(14,3);default:0" json:"scrapped" swaggertype:"number"`
+	MovementID  *uint     `json:"movement_id,omitempty"`
+	UserID      *uint     `gorm:"index" json:"user_id,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// TableName specifies the table name for WorkOrderCompletion model
+func (WorkOrderCompletion) TableName() string {
+	return "work_order_completions"
+}
+
+// WorkOrderResponse is the DTO for work order responses
+type WorkOrderResponse struct {
+	ID                uint                          `json:"id"`
+	Number            string                        `json:"number"`
+	ProductID         uint                          `json:"product_id"`
+	ProductName       string                        `json:"product_name,omitempty"`
+	SKU               string                        `json:"sku,omitempty"`
+	WarehouseID       uint                          `json:"warehouse_id"`
+	WarehouseCode     string                        `json:"warehouse_code,omitempty"`
+	Quantity          Quantity                      `json:"quantity" swaggertype:"number"`
+	QuantityCompleted Quantity                      `json:"quantity_completed" swaggertype:"number"`
+	QuantityScrapped  Quantity                      `json:"quantity_scrapped" swaggertype:"number"`
+	Outstanding       Quantity                      `json:"outstanding" swaggertype:"number"`
+	Status            WorkOrderStatus               `json:"status"`
+	Notes             string                        `json:"notes"`
+	StartedAt         *time.Time                    `json:"started_at,omitempty"`
+	CompletedAt       *time.Time                    `json:"completed_at,omitempty"`
+	CancelledAt       *time.Time                    `json:"cancelled_at,omitempty"`
+	UserID            *uint                         `json:"user_id,omitempty"`
+	Components        []WorkOrderComponentResponse  `json:"components"`
+	Completions       []WorkOrderCompletionResponse `json:"completions"`
+	CreatedAt         time.Time                     `json:"created_at"`
+	UpdatedAt         time.Time                     `json:"updated_at"`
+}
+
+// WorkOrderComponentResponse is the DTO for work order component responses
+type WorkOrderComponentResponse struct {
+	ID               uint     `json:"id"`
+	ProductID        uint     `json:"product_id"`
+	ProductName      string   `json:"product_name,omitempty"`
+	SKU              string   `json:"sku,omitempty"`
+	QuantityPerUnit  Quantity `json:"quantity_per_unit" swaggertype:"number"`
+	QuantityRequired Quantity `json:"quantity_required" swaggertype:"number"`
+	QuantityConsumed Quantity `json:"quantity_consumed" swaggertype:"number"`
+}
+
+// WorkOrderCompletionResponse is the DTO for work order completion responses
+type WorkOrderCompletionResponse struct {
+	ID         uint      `json:"id"`
+	Quantity   Quantity  `json:"quantity" swaggertype:"number"`
+	Scrapped   Quantity  `json:"scrapped" swaggertype:"number"`
+	MovementID *uint     `json:"movement_id,omitempty"`
+	UserID     *uint     `json:"user_id,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// ToResponse converts WorkOrder to WorkOrderResponse
+func (w *WorkOrder) ToResponse() WorkOrderResponse {
+	response := WorkOrderResponse{
+		ID:                w.ID,
+		Number:            w.Number,
+		ProductID:         w.ProductID,
+		ProductName:       w.Product.Name,
+		SKU:               w.Product.SKU,
+		WarehouseID:       w.WarehouseID,
+		WarehouseCode:     w.Warehouse.Code,
+		Quantity:          w.Quantity,
+		QuantityCompleted: w.QuantityCompleted,
+		QuantityScrapped:  w.QuantityScrapped,
+		Outstanding:       w.Outstanding(),
+		Status:            w.Status,
+		Notes:             w.Notes,
+		StartedAt:         w.StartedAt,
+		CompletedAt:       w.CompletedAt,
+		CancelledAt:       w.CancelledAt,
+		UserID:            w.UserID,
+		Components:        make([]WorkOrderComponentResponse, len(w.Components)),
+		Completions:       make([]WorkOrderCompletionResponse, len(w.Completions)),
+		CreatedAt:         w.CreatedAt,
+		UpdatedAt:         w.UpdatedAt,
+	}
+
+	for i, component := range w.Components {
+		response.Components[i] = WorkOrderComponentResponse{
+			ID:               component.ID,
+			ProductID:        component.ProductID,
+			ProductName:      component.Product.Name,
+			SKU:              component.Product.SKU,
+			QuantityPerUnit:  component.QuantityPerUnit,
+			QuantityRequired: component.QuantityPerUnit.Mul(w.Quantity),
+			QuantityConsumed: component.QuantityConsumed,
+		}
+	}
+
+	for i, completion := range w.Completions {
+		response.Completions[i] = WorkOrderCompletionResponse{
+			ID:         completion.ID,
+			Quantity:   completion.Quantity,
+			Scrapped:   completion.Scrapped,
+			MovementID: completion.MovementID,
+			UserID:     completion.UserID,
+			CreatedAt:  completion.CreatedAt,
+		}
+	}
+
+	return response
+}
+
+// WorkOrderComponentRequest is the DTO for a component of a work order
+type WorkOrderComponentRequest struct {
+	ProductID       uint     `json:"product_id" binding:"required"`
+	QuantityPerUnit Quantity `json:"quantity_per_unit" binding:"required,gt=0" swaggertype:"number"`
+}
+
+// CreateWorkOrderRequest is the DTO for creating a draft work order.
+// WarehouseID defaults to the default warehouse.
+type CreateWorkOrderRequest struct {
+	ProductID   uint                        `json:"product_id" binding:"required"`
+	WarehouseID uint                        `json:"warehouse_id"`
+	Quantity    Quantity                    `json:"quantity" binding:"required,gt=0" swaggertype:"number"`
+	Notes       string                      `json:"notes" binding:"max=1000"`
+	Components  []WorkOrderComponentRequest `json:"components" binding:"required,min=1,max=50,dive"`
+}
+
+// CompleteWorkOrderRequest is the DTO for booking built and scrapped units
+// against a work order. Both consume components; only built units add
+// stock. A lot-tracked finished product must name the lot built and a
+// serialized one must list the units built. Components lists the units of
+// serialized components consumed, by work order component ID.
+type CompleteWorkOrderRequest struct {
+	Quantity   Quantity             `json:"quantity" binding:"min=0" swaggertype:"number"`
+	Scrapped   Quantity             `json:"scrapped" binding:"min=0" swaggertype:"number"`
+	Components []LineSerialsRequest `json:"components" binding:"omitempty,dive"`
+	LotInput
+	SerialInput
+}
+
+// WorkOrderListQuery is the DTO for work order listing query parameters
+type WorkOrderListQuery struct {
+	PaginationRequest
+	ProductID uint   `form:"product_id"`
+	Status    string `form:"status" binding:"omitempty,oneof=draft in_progress completed cancelled"`
+}
@@ -0,0 +1,210 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type AlertHandler struct {
+	alertService service.AlertService
+}
+
+func NewAlertHandler(alertService service.AlertService) *AlertHandler {
+	return &AlertHandler{alertService: alertService}
+}
+
+// List godoc
+// @Summary      List stock alerts
+// @Description  Get paginated list of low-stock and out-of-stock alerts, newest first
+// @Tags         alerts
+// @Produce      json
+// @Param        product_id query int false "Filter by product ID"
+// @Param        type query string false "Filter by type (low, out)"
+// @Param        status query string false "Filter by status (open, acknowledged, resolved)"
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /alerts [get]
+func (h *AlertHandler) List(c *gin.Context) {
+	var query models.StockAlertQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page := query.GetPage()
+	pageSize := query.GetPageSize()
+
+	var productID *uint
+	if query.ProductID > 0 {
+		productID = &query.ProductID
+	}
+
+	alerts, total, err := h.alertService.List(productID, query.Type, query.Status, page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve alerts",
+		})
+		return
+	}
+
+	responses := make([]models.StockAlertResponse, len(alerts))
+	for i, a := range alerts {
+		responses[i] = a.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
+}
+
+// Get godoc
+// @Summary      Get stock alert by ID
+// @Description  Get a single stock alert by its ID
+// @Tags         alerts
+// @Produce      json
+// @Param        id path int true "Alert ID"
+// @Success      200  {object}  models.StockAlertResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /alerts/{id} [get]
+func (h *AlertHandler) Get(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid alert ID",
+		})
+		return
+	}
+
+	alert, err := h.alertService.GetByID(uint(id))
+	if err != nil {
+		if errors.Is(err, repository.ErrAlertNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Alert not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve alert",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, alert.ToResponse())
+}
+
+// Acknowledge godoc
+// @Summary      Acknowledge stock alert
+// @Description  Mark an open alert as acknowledged (admin only)
+// @Tags         alerts
+// @Produce      json
+// @Param        id path int true "Alert ID"
+// @Success      200  {object}  models.StockAlertResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /alerts/{id}/acknowledge [post]
+func (h *AlertHandler) Acknowledge(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid alert ID",
+		})
+		return
+	}
+
+	alert, err := h.alertService.Acknowledge(uint(id), c.GetUint("userID"))
+	if err != nil {
+		if errors.Is(err, repository.ErrAlertNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Alert not found",
+			})
+			return
+		}
+		if errors.Is(err, service.ErrAlertNotOpen) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Only open alerts can be acknowledged",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to acknowledge alert",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, alert.ToResponse())
+}
+
+// Resolve godoc
+// @Summary      Resolve stock alert
+// @Description  Mark an alert as resolved (admin only). Alerts also resolve automatically once stock recovers.
+// @Tags         alerts
+// @Produce      json
+// @Param        id path int true "Alert ID"
+// @Success      200  {object}  models.StockAlertResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /alerts/{id}/resolve [post]
+func (h *AlertHandler) Resolve(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid alert ID",
+		})
+		return
+	}
+
+	alert, err := h.alertService.Resolve(uint(id))
+	if err != nil {
+		if errors.Is(err, repository.ErrAlertNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Alert not found",
+			})
+			return
+		}
+		if errors.Is(err, service.ErrAlertResolved) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Alert is already resolved",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to resolve alert",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, alert.ToResponse())
+}
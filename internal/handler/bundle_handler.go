@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type BundleHandler struct {
+	bundleService service.BundleService
+}
+
+func NewBundleHandler(bundleService service.BundleService) *BundleHandler {
+	return &BundleHandler{bundleService: bundleService}
+}
+
+// SetComponents godoc
+// @Summary      Set bundle components
+// @Description  Replace a product's bill of materials, making it a bundle whose stock is made up of its components. An empty list makes it a regular product again. (admin only)
+// @Tags         bundles
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Bundle product ID"
+// @Param        request body models.SetBundleComponentsRequest true "Components and quantity per bundle"
+// @Success      200  {object}  models.ProductResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /products/{id}/components [put]
+func (h *BundleHandler) SetComponents(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid product ID",
+		})
+		return
+	}
+
+	var req models.SetBundleComponentsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	product, err := h.bundleService.SetComponents(uint(id), &req)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrProductNotFound):
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Product not found",
+			})
+		case errors.Is(err, repository.ErrBundleNesting):
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation_error",
+				Message: "Bundles cannot contain themselves or other bundles, or be components",
+			})
+		case errors.Is(err, repository.ErrBundleHasStock):
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Product holds stock of its own; bring it to zero first",
+			})
+		case errors.Is(err, repository.ErrBundleInUse):
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Bundle has active reservations",
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to set bundle components",
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, product.ToResponse())
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type LotHandler struct {
+	lotService service.LotService
+}
+
+func NewLotHandler(lotService service.LotService) *LotHandler {
+	return &LotHandler{lotService: lotService}
+}
+
+// ListByProduct godoc
+// @Summary      List product lots
+// @Description  Get a product's lots in picking order (first expiring first)
+// @Tags         lots
+// @Produce      json
+// @Param        id path int true "Product ID"
+// @Param        warehouse_id query int false "Filter by warehouse ID"
+// @Param        include_empty query bool false "Include lots with no stock left"
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /products/{id}/lots [get]
+func (h *LotHandler) ListByProduct(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid product ID",
+		})
+		return
+	}
+
+	var query models.LotListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page := query.GetPage()
+	pageSize := query.GetPageSize()
+
+	var warehouseID *uint
+	if query.WarehouseID > 0 {
+		warehouseID = &query.WarehouseID
+	}
+
+	lots, total, err := h.lotService.ListByProduct(uint(id), warehouseID, query.IncludeEmpty, page, pageSize)
+	if err != nil {
+		if errors.Is(err, repository.ErrProductNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Product not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve lots",
+		})
+		return
+	}
+
+	responses := make([]models.LotResponse, len(lots))
+	for i, l := range lots {
+		responses[i] = l.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
+}
+
+// ListExpiring godoc
+// @Summary      List expiring lots
+// @Description  Get lots with stock that expire within the given number of days, soonest first. Already expired lots are included.
+// @Tags         lots
+// @Produce      json
+// @Param        days query int false "Look-ahead window in days" default(30)
+// @Param        warehouse_id query int false "Filter by warehouse ID"
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /lots/expiring [get]
+func (h *LotHandler) ListExpiring(c *gin.Context) {
+	var query models.ExpiringLotQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page := query.GetPage()
+	pageSize := query.GetPageSize()
+
+	var warehouseID *uint
+	if query.WarehouseID > 0 {
+		warehouseID = &query.WarehouseID
+	}
+
+	lots, total, err := h.lotService.ListExpiring(query.GetDays(), warehouseID, page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve expiring lots",
+		})
+		return
+	}
+
+	responses := make([]models.LotResponse, len(lots))
+	for i, l := range lots {
+		responses[i] = l.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
+}
@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type PriceChangeHandler struct {
+	priceChangeService service.PriceChangeService
+}
+
+func NewPriceChangeHandler(priceChangeService service.PriceChangeService) *PriceChangeHandler {
+	return &PriceChangeHandler{priceChangeService: priceChangeService}
+}
+
+// List godoc
+// @Summary      List scheduled price changes
+// @Description  Get a product's scheduled price changes, soonest first. Without a status, the upcoming changes (pending or active) are listed.
+// @Tags         price-changes
+// @Produce      json
+// @Param        id path int true "Product ID"
+// @Param        status query string false "Filter by status (pending, active, completed, cancelled, expired)"
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /products/{id}/price-changes [get]
+func (h *PriceChangeHandler) List(c *gin.Context) {
+	productID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid product ID",
+		})
+		return
+	}
+
+	var query models.PriceChangeListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page := query.GetPage()
+	pageSize := query.GetPageSize()
+
+	changes, total, err := h.priceChangeService.List(uint(productID), query.Status, page, pageSize)
+	if err != nil {
+		writePriceChangeError(c, err, "Failed to retrieve scheduled price changes")
+		return
+	}
+
+	responses := make([]models.ScheduledPriceChangeResponse, len(changes))
+	for i, change := range changes {
+		responses[i] = change.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
+}
+
+// Create godoc
+// @Summary      Schedule price change
+// @Description  Schedule a future price for a product. With ends_at the change is a promotion and the earlier price is restored when it ends. Changes for a product may not overlap. (admin only)
+// @Tags         price-changes
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Product ID"
+// @Param        request body models.CreatePriceChangeRequest true "Price change data"
+// @Success      201  {object}  models.ScheduledPriceChangeResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /products/{id}/price-changes [post]
+func (h *PriceChangeHandler) Create(c *gin.Context) {
+	productID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid product ID",
+		})
+		return
+	}
+
+	var req models.CreatePriceChangeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	change, err := h.priceChangeService.Schedule(uint(productID), &req, c.GetUint("userID"))
+	if err != nil {
+		writePriceChangeError(c, err, "Failed to schedule price change")
+		return
+	}
+
+	c.JSON(http.StatusCreated, change.ToResponse())
+}
+
+// Cancel godoc
+// @Summary      Cancel scheduled price change
+// @Description  Cancel a price change that has not been applied yet (admin only)
+// @Tags         price-changes
+// @Produce      json
+// @Param        id path int true "Product ID"
+// @Param        change_id path int true "Price change ID"
+// @Success      200  {object}  models.ScheduledPriceChangeResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /products/{id}/price-changes/{change_id}/cancel [post]
+func (h *PriceChangeHandler) Cancel(c *gin.Context) {
+	productID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid product ID",
+		})
+		return
+	}
+	changeID, err := strconv.ParseUint(c.Param("change_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid price change ID",
+		})
+		return
+	}
+
+	change, err := h.priceChangeService.Cancel(uint(productID), uint(changeID))
+	if err != nil {
+		writePriceChangeError(c, err, "Failed to cancel price change")
+		return
+	}
+
+	c.JSON(http.StatusOK, change.ToResponse())
+}
+
+// writePriceChangeError maps scheduled price change errors to HTTP responses
+func writePriceChangeError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, repository.ErrProductNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Product not found",
+		})
+	case errors.Is(err, repository.ErrPriceChangeNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Scheduled price change not found",
+		})
+	case errors.Is(err, service.ErrInvalidPriceSchedule):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Price change must start in the future and end after it starts",
+		})
+	case errors.Is(err, repository.ErrPriceChangeOverlap):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Price change overlaps another scheduled change for this product",
+		})
+	case errors.Is(err, repository.ErrPriceChangeNotPending):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Only pending price changes can be cancelled",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: message,
+		})
+	}
+}
@@ -0,0 +1,341 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type PriceListHandler struct {
+	priceListService service.PriceListService
+}
+
+func NewPriceListHandler(priceListService service.PriceListService) *PriceListHandler {
+	return &PriceListHandler{priceListService: priceListService}
+}
+
+// List godoc
+// @Summary      List price lists
+// @Description  Get paginated list of price lists, optionally filtered by code or name
+// @Tags         price-lists
+// @Produce      json
+// @Param        search query string false "Search by code or name"
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /price-lists [get]
+func (h *PriceListHandler) List(c *gin.Context) {
+	var query models.PriceListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page := query.GetPage()
+	pageSize := query.GetPageSize()
+
+	lists, total, err := h.priceListService.List(page, pageSize, query.Search)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve price lists",
+		})
+		return
+	}
+
+	responses := make([]models.PriceListResponse, len(lists))
+	for i, l := range lists {
+		responses[i] = l.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
+}
+
+// Get godoc
+// @Summary      Get price list by ID
+// @Description  Get a single price list with its prices
+// @Tags         price-lists
+// @Produce      json
+// @Param        id path int true "Price list ID"
+// @Success      200  {object}  models.PriceListResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /price-lists/{id} [get]
+func (h *PriceListHandler) Get(c *gin.Context) {
+	id, ok := parsePriceListID(c)
+	if !ok {
+		return
+	}
+
+	list, err := h.priceListService.GetByID(id)
+	if err != nil {
+		writePriceListError(c, err, "Failed to retrieve price list")
+		return
+	}
+
+	c.JSON(http.StatusOK, list.ToResponse())
+}
+
+// Create godoc
+// @Summary      Create price list
+// @Description  Create a new price list for a group of customers (admin only)
+// @Tags         price-lists
+// @Accept       json
+// @Produce      json
+// @Param        request body models.CreatePriceListRequest true "Price list data"
+// @Success      201  {object}  models.PriceListResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /price-lists [post]
+func (h *PriceListHandler) Create(c *gin.Context) {
+	var req models.CreatePriceListRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	list, err := h.priceListService.Create(&req)
+	if err != nil {
+		writePriceListError(c, err, "Failed to create price list")
+		return
+	}
+
+	c.JSON(http.StatusCreated, list.ToResponse())
+}
+
+// Update godoc
+// @Summary      Update price list
+// @Description  Update an existing price list (admin only)
+// @Tags         price-lists
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Price list ID"
+// @Param        request body models.UpdatePriceListRequest true "Price list data"
+// @Success      200  {object}  models.PriceListResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /price-lists/{id} [put]
+func (h *PriceListHandler) Update(c *gin.Context) {
+	id, ok := parsePriceListID(c)
+	if !ok {
+		return
+	}
+
+	var req models.UpdatePriceListRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	list, err := h.priceListService.Update(id, &req)
+	if err != nil {
+		writePriceListError(c, err, "Failed to update price list")
+		return
+	}
+
+	c.JSON(http.StatusOK, list.ToResponse())
+}
+
+// Delete godoc
+// @Summary      Delete price list
+// @Description  Delete a price list and its prices (admin only)
+// @Tags         price-lists
+// @Produce      json
+// @Param        id path int true "Price list ID"
+// @Success      200  {object}  models.SuccessResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /price-lists/{id} [delete]
+func (h *PriceListHandler) Delete(c *gin.Context) {
+	id, ok := parsePriceListID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.priceListService.Delete(id); err != nil {
+		writePriceListError(c, err, "Failed to delete price list")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse{
+		Message: "Price list deleted successfully",
+	})
+}
+
+// SetPrices godoc
+// @Summary      Set price list prices
+// @Description  Replace all prices on a price list. A product may have several prices, each applying from a minimum quantity in base units. (admin only)
+// @Tags         price-lists
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Price list ID"
+// @Param        request body models.SetPriceListPricesRequest true "Prices"
+// @Success      200  {object}  models.PriceListResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /price-lists/{id}/prices [put]
+func (h *PriceListHandler) SetPrices(c *gin.Context) {
+	id, ok := parsePriceListID(c)
+	if !ok {
+		return
+	}
+
+	var req models.SetPriceListPricesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	list, err := h.priceListService.SetPrices(id, &req)
+	if err != nil {
+		writePriceListError(c, err, "Failed to set price list prices")
+		return
+	}
+
+	c.JSON(http.StatusOK, list.ToResponse())
+}
+
+// ResolvePrice godoc
+// @Summary      Resolve product price
+// @Description  Get the unit price and total for a quantity of a product on a price list, using the quantity break with the highest minimum quantity that applies. Without a list, or when the list has no applicable price, the product's own price is used.
+// @Tags         price-lists
+// @Produce      json
+// @Param        id path int true "Product ID"
+// @Param        list query string false "Price list code"
+// @Param        qty query number false "Quantity in base units" default(1)
+// @Success      200  {object}  models.ResolvedPrice
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /products/{id}/price [get]
+func (h *PriceListHandler) ResolvePrice(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid product ID",
+		})
+		return
+	}
+
+	var query models.ResolvePriceQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	quantity, err := query.GetQuantity()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	price, err := h.priceListService.ResolvePrice(uint(id), query.List, quantity)
+	if err != nil {
+		writePriceListError(c, err, "Failed to resolve price")
+		return
+	}
+
+	c.JSON(http.StatusOK, price)
+}
+
+// parsePriceListID reads the price list ID path parameter, writing a 400
+// response if it is invalid
+func parsePriceListID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid price list ID",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// writePriceListError maps price list errors to HTTP responses
+func writePriceListError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, repository.ErrPriceListNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Price list not found",
+		})
+	case errors.Is(err, repository.ErrProductNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Product not found",
+		})
+	case errors.Is(err, repository.ErrPriceListAlreadyExists):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Price list with this code already exists",
+		})
+	case errors.Is(err, repository.ErrDuplicatePriceTier):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "A product may only have one price per minimum quantity",
+		})
+	case errors.Is(err, repository.ErrPriceListCurrency):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Products must be priced in the price list's currency",
+		})
+	case errors.Is(err, service.ErrListCurrencyMismatch):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Price list is in a different currency than the product",
+		})
+	case errors.Is(err, repository.ErrPriceListCurrencyFixed):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Price list currency cannot change once it has prices",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: message,
+		})
+	}
+}
@@ -13,17 +13,32 @@ import (
 )
 
 type ProductHandler struct {
-	productService service.ProductService
+	productService   service.ProductService
+	priceListService service.PriceListService
+	taxService       service.TaxService
 }
 
-func NewProductHandler(productService service.ProductService) *ProductHandler {
-	return &ProductHandler{productService: productService}
+func NewProductHandler(productService service.ProductService, priceListService service.PriceListService, taxService service.TaxService) *ProductHandler {
+	return &ProductHandler{
+		productService:   productService,
+		priceListService: priceListService,
+		taxService:       taxService,
+	}
 }
 
 type ProductListQuery struct {
 	models.PaginationRequest
-	CategoryID uint   `form:"category_id"`
-	Search     string `form:"search"`
+	CategoryID uint    `form:"category_id"`
+	Search     string  `form:"search"`
+	Unit       string  `form:"unit" binding:"max=20"`
+	PriceList  string  `form:"price_list" binding:"max=50"`
+	Quantity   float64 `form:"qty" binding:"omitempty,gt=0"`
+	models.TaxQuery
+}
+
+type ProductQuery struct {
+	models.UnitQuery
+	models.TaxQuery
 }
 
 // List godoc
@@ -35,7 +50,13 @@ type ProductListQuery struct {
 // @Param        page_size query int false "Page size" default(10)
 // @Param        category_id query int false "Filter by category ID"
 // @Param        search query string false "Search by name or SKU"
+// @Param        unit query string false "Also render stock in this unit for products that have it"
+// @Param        price_list query string false "Include each product's resolved price on this price list"
+// @Param        qty query number false "Quantity in base units to resolve prices for" default(1)
+// @Param        tax_jurisdiction query string false "Include each product's net, tax and gross price in this jurisdiction"
 // @Success      200  {object}  map[string]interface{}
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
 // @Failure      500  {object}  models.ErrorResponse
 // @Security     BearerAuth
 // @Router       /products [get]
@@ -66,9 +87,43 @@ func (h *ProductHandler) List(c *gin.Context) {
 		return
 	}
 
+	var prices map[uint]models.ResolvedPrice
+	if query.PriceList != "" {
+		quantity, err := models.QueryQuantity(query.Quantity)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation_error",
+				Message: err.Error(),
+			})
+			return
+		}
+		if prices, err = h.priceListService.ResolvePrices(products, query.PriceList, quantity); err != nil {
+			writePriceListError(c, err, "Failed to resolve prices")
+			return
+		}
+	}
+
+	var taxes map[uint]models.ProductTax
+	if query.TaxJurisdiction != "" {
+		if taxes, err = h.taxService.ApplyTax(products, query.TaxJurisdiction); err != nil {
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to apply tax",
+			})
+			return
+		}
+	}
+
+	// Products without the requested unit are rendered in their base unit only
 	responses := make([]models.ProductResponse, len(products))
 	for i, prod := range products {
-		responses[i] = prod.ToResponse()
+		responses[i], _ = prod.ToResponseIn(query.Unit)
+		if price, ok := prices[prod.ID]; ok {
+			responses[i].ResolvedPrice = &price
+		}
+		if tax, ok := taxes[prod.ID]; ok {
+			responses[i].Tax = &tax
+		}
 	}
 
 	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
@@ -80,6 +135,8 @@ func (h *ProductHandler) List(c *gin.Context) {
 // @Tags         products
 // @Produce      json
 // @Param        id path int true "Product ID"
+// @Param        unit query string false "Also render stock in this unit"
+// @Param        tax_jurisdiction query string false "Include the product's net, tax and gross price in this jurisdiction"
 // @Success      200  {object}  models.ProductResponse
 // @Failure      400  {object}  models.ErrorResponse
 // @Failure      404  {object}  models.ErrorResponse
@@ -95,6 +152,15 @@ func (h *ProductHandler) Get(c *gin.Context) {
 		return
 	}
 
+	var query ProductQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
 	product, err := h.productService.GetByID(uint(id))
 	if err != nil {
 		if errors.Is(err, repository.ErrProductNotFound) {
@@ -111,7 +177,26 @@ func (h *ProductHandler) Get(c *gin.Context) {
 		return
 	}
 
-	c.JSON(http.StatusOK, product.ToResponse())
+	response, ok := product.ToResponseIn(query.Unit)
+	if !ok {
+		writeUnitError(c, repository.ErrUnknownUnit)
+		return
+	}
+
+	if query.TaxJurisdiction != "" {
+		taxes, err := h.taxService.ApplyTax([]models.Product{*product}, query.TaxJurisdiction)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to apply tax",
+			})
+			return
+		}
+		tax := taxes[product.ID]
+		response.Tax = &tax
+	}
+
+	c.JSON(http.StatusOK, response)
 }
 
 // Create godoc
@@ -140,6 +225,9 @@ func (h *ProductHandler) Create(c *gin.Context) {
 
 	product, err := h.productService.Create(&req)
 	if err != nil {
+		if writeSerialError(c, err) || writeUnitError(c, err) {
+			return
+		}
 		if errors.Is(err, repository.ErrProductSKUExists) {
 			c.JSON(http.StatusConflict, models.ErrorResponse{
 				Error:   "conflict",
@@ -154,6 +242,20 @@ func (h *ProductHandler) Create(c *gin.Context) {
 			})
 			return
 		}
+		if errors.Is(err, repository.ErrInvalidTaxClass) {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation_error",
+				Message: "Invalid tax class ID",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrNoDefaultWarehouse) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "No default warehouse configured",
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "internal_error",
 			Message: "Failed to create product",
@@ -201,6 +303,9 @@ func (h *ProductHandler) Update(c *gin.Context) {
 
 	product, err := h.productService.Update(uint(id), &req)
 	if err != nil {
+		if writeSerialError(c, err) || writeUnitError(c, err) {
+			return
+		}
 		if errors.Is(err, repository.ErrProductNotFound) {
 			c.JSON(http.StatusNotFound, models.ErrorResponse{
 				Error:   "not_found",
@@ -208,6 +313,41 @@ func (h *ProductHandler) Update(c *gin.Context) {
 			})
 			return
 		}
+		if errors.Is(err, repository.ErrStockHeldElsewhere) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Stock is held at other warehouses; adjust it per warehouse",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrBundleAbsoluteStock) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Bundle stock follows its components; record a movement instead",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrCurrencyInUse) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Currency cannot change once the product has stock, movements, scheduled or price list prices, or open orders",
+			})
+			return
+		}
+		if errors.Is(err, service.ErrTrackingWithStock) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Lot tracking and serial numbers can only be switched while the product has no stock",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrNoDefaultWarehouse) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "No default warehouse configured",
+			})
+			return
+		}
 		if errors.Is(err, repository.ErrProductSKUExists) {
 			c.JSON(http.StatusConflict, models.ErrorResponse{
 				Error:   "conflict",
@@ -222,6 +362,13 @@ func (h *ProductHandler) Update(c *gin.Context) {
 			})
 			return
 		}
+		if errors.Is(err, repository.ErrInvalidTaxClass) {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation_error",
+				Message: "Invalid tax class ID",
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "internal_error",
 			Message: "Failed to update product",
@@ -243,6 +390,7 @@ func (h *ProductHandler) Update(c *gin.Context) {
 // @Failure      401  {object}  models.ErrorResponse
 // @Failure      403  {object}  models.ErrorResponse
 // @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
 // @Security     BearerAuth
 // @Router       /products/{id} [delete]
 func (h *ProductHandler) Delete(c *gin.Context) {
@@ -264,6 +412,20 @@ func (h *ProductHandler) Delete(c *gin.Context) {
 			})
 			return
 		}
+		if errors.Is(err, repository.ErrProductHasVariants) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Delete the product's variants first",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrProductInBundle) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Product is a component of a bundle",
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "internal_error",
 			Message: "Failed to delete product",
@@ -310,8 +472,11 @@ func (h *ProductHandler) UpdateStock(c *gin.Context) {
 		return
 	}
 
-	product, err := h.productService.UpdateStock(uint(id), req.Stock)
+	product, err := h.productService.UpdateStock(uint(id), req.Stock, req.Unit, c.GetUint("userID"))
 	if err != nil {
+		if writeSerialError(c, err) || writeUnitError(c, err) {
+			return
+		}
 		if errors.Is(err, repository.ErrProductNotFound) {
 			c.JSON(http.StatusNotFound, models.ErrorResponse{
 				Error:   "not_found",
@@ -319,6 +484,27 @@ func (h *ProductHandler) UpdateStock(c *gin.Context) {
 			})
 			return
 		}
+		if errors.Is(err, repository.ErrStockHeldElsewhere) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Stock is held at other warehouses; adjust it per warehouse",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrBundleAbsoluteStock) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Bundle stock follows its components; record a movement instead",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrNoDefaultWarehouse) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "No default warehouse configured",
+			})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "internal_error",
 			Message: "Failed to update stock",
@@ -329,6 +515,51 @@ func (h *ProductHandler) UpdateStock(c *gin.Context) {
 	c.JSON(http.StatusOK, product.ToResponse())
 }
 
+// GetStockLevels godoc
+// @Summary      Get product stock per warehouse
+// @Description  Get the quantity of a product held at each warehouse
+// @Tags         products
+// @Produce      json
+// @Param        id path int true "Product ID"
+// @Success      200  {array}   models.ProductStockResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /products/{id}/stock [get]
+func (h *ProductHandler) GetStockLevels(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid product ID",
+		})
+		return
+	}
+
+	stocks, err := h.productService.GetStockLevels(uint(id))
+	if err != nil {
+		if errors.Is(err, repository.ErrProductNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Product not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve stock levels",
+		})
+		return
+	}
+
+	responses := make([]models.ProductStockResponse, len(stocks))
+	for i, s := range stocks {
+		responses[i] = s.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, responses)
+}
+
 // GetHistory godoc
 // @Summary      Get product history
 // @Description  Get the price and stock change history for a product
@@ -367,39 +598,234 @@ func (h *ProductHandler) GetHistory(c *gin.Context) {
 	pageSize := query.GetPageSize()
 
 	// Parse date filters
-	var startDate, endDate *time.Time
-	if query.Start != "" {
-		t, err := time.Parse("2006-01-02", query.Start)
-		if err != nil {
-			// Try RFC3339 format
-			t, err = time.Parse(time.RFC3339, query.Start)
-			if err != nil {
-				c.JSON(http.StatusBadRequest, models.ErrorResponse{
-					Error:   "validation_error",
-					Message: "Invalid start date format. Use YYYY-MM-DD or RFC3339",
-				})
-				return
-			}
+	startDate, err := parseDate(query.Start)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid start date format. Use YYYY-MM-DD or RFC3339",
+		})
+		return
+	}
+	endDate, err := parseDate(query.End)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid end date format. Use YYYY-MM-DD or RFC3339",
+		})
+		return
+	}
+
+	history, total, err := h.productService.GetHistory(uint(id), startDate, endDate, page, pageSize)
+	if err != nil {
+		if errors.Is(err, repository.ErrProductNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Product not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve product history",
+		})
+		return
+	}
+
+	responses := make([]models.ProductHistoryResponse, len(history))
+	for i, h := range history {
+		responses[i] = h.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
+}
+
+// RecordMovement godoc
+// @Summary      Record stock movement
+// @Description  Apply a signed stock delta (receipt, sale, adjustment, damage or return) at a warehouse and record it in the ledger (admin only)
+// @Tags         products
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Product ID"
+// @Param        request body models.CreateStockMovementRequest true "Movement data"
+// @Success      201  {object}  models.StockMovementResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /products/{id}/movements [post]
+func (h *ProductHandler) RecordMovement(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid product ID",
+		})
+		return
+	}
+
+	var req models.CreateStockMovementRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	movement, err := h.productService.RecordMovement(uint(id), &req, c.GetUint("userID"))
+	if err != nil {
+		if writeSerialError(c, err) || writeUnitError(c, err) {
+			return
+		}
+		if errors.Is(err, service.ErrInvalidMovementQuantity) {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation_error",
+				Message: "Quantity must be positive for receipt/return, negative for sale/damage and non-zero for adjustment",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrLotRequired) {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation_error",
+				Message: "A lot number is required to receive a lot-tracked product",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrProductNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Product not found",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrWarehouseNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Warehouse not found",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrInsufficientStock) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Insufficient stock at this warehouse",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrLotExpired) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Only expired lots are left to cover the quantity",
+			})
+			return
 		}
-		startDate = &t
+		if errors.Is(err, repository.ErrInsufficientAvailability) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Stock is held by reservations",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrNoDefaultWarehouse) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "No default warehouse configured",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to record stock movement",
+		})
+		return
 	}
-	if query.End != "" {
-		t, err := time.Parse("2006-01-02", query.End)
+
+	c.JSON(http.StatusCreated, movement.ToResponse())
+}
+
+// ListMovements godoc
+// @Summary      List stock movements
+// @Description  Get the paginated stock movement ledger of a product, newest first
+// @Tags         products
+// @Produce      json
+// @Param        id path int true "Product ID"
+// @Param        type query string false "Filter by movement type"
+// @Param        start query string false "Start date (YYYY-MM-DD)"
+// @Param        end query string false "End date (YYYY-MM-DD)"
+// @Param        unit query string false "Also render quantities in this unit"
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /products/{id}/movements [get]
+func (h *ProductHandler) ListMovements(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid product ID",
+		})
+		return
+	}
+
+	var query models.StockMovementQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page := query.GetPage()
+	pageSize := query.GetPageSize()
+
+	startDate, err := parseDate(query.Start)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid start date format. Use YYYY-MM-DD or RFC3339",
+		})
+		return
+	}
+	endDate, err := parseDate(query.End)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid end date format. Use YYYY-MM-DD or RFC3339",
+		})
+		return
+	}
+
+	factor := models.Units(1)
+	if query.Unit != "" {
+		product, err := h.productService.GetByID(uint(id))
 		if err != nil {
-			// Try RFC3339 format
-			t, err = time.Parse(time.RFC3339, query.End)
-			if err != nil {
-				c.JSON(http.StatusBadRequest, models.ErrorResponse{
-					Error:   "validation_error",
-					Message: "Invalid end date format. Use YYYY-MM-DD or RFC3339",
+			if errors.Is(err, repository.ErrProductNotFound) {
+				c.JSON(http.StatusNotFound, models.ErrorResponse{
+					Error:   "not_found",
+					Message: "Product not found",
 				})
 				return
 			}
+			c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+				Error:   "internal_error",
+				Message: "Failed to retrieve stock movements",
+			})
+			return
+		}
+		var ok bool
+		if factor, ok = product.UnitFactor(query.Unit); !ok {
+			writeUnitError(c, repository.ErrUnknownUnit)
+			return
 		}
-		endDate = &t
 	}
 
-	history, total, err := h.productService.GetHistory(uint(id), startDate, endDate, page, pageSize)
+	movements, total, err := h.productService.ListMovements(uint(id), query.Type, startDate, endDate, page, pageSize)
 	if err != nil {
 		if errors.Is(err, repository.ErrProductNotFound) {
 			c.JSON(http.StatusNotFound, models.ErrorResponse{
@@ -410,15 +836,31 @@ func (h *ProductHandler) GetHistory(c *gin.Context) {
 		}
 		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
 			Error:   "internal_error",
-			Message: "Failed to retrieve product history",
+			Message: "Failed to retrieve stock movements",
 		})
 		return
 	}
 
-	responses := make([]models.ProductHistoryResponse, len(history))
-	for i, h := range history {
-		responses[i] = h.ToResponse()
+	responses := make([]models.StockMovementResponse, len(movements))
+	for i, m := range movements {
+		responses[i] = m.ToResponseIn(query.Unit, factor)
 	}
 
 	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
 }
+
+// parseDate parses an optional YYYY-MM-DD or RFC3339 query value
+func parseDate(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		// Try RFC3339 format
+		t, err = time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &t, nil
+}
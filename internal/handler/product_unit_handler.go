@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type ProductUnitHandler struct {
+	unitService service.ProductUnitService
+}
+
+func NewProductUnitHandler(unitService service.ProductUnitService) *ProductUnitHandler {
+	return &ProductUnitHandler{unitService: unitService}
+}
+
+// SetUnits godoc
+// @Summary      Set product units
+// @Description  Replace a product's alternative units of measure, each with the number of base units it holds, and optionally rename its base unit. Stock is kept in the base unit. (admin only)
+// @Tags         units
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Product ID"
+// @Param        request body models.SetProductUnitsRequest true "Base unit and alternative units"
+// @Success      200  {object}  models.ProductResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /products/{id}/units [put]
+func (h *ProductUnitHandler) SetUnits(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid product ID",
+		})
+		return
+	}
+
+	var req models.SetProductUnitsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	product, err := h.unitService.SetUnits(uint(id), &req)
+	if err != nil {
+		if writeUnitError(c, err) {
+			return
+		}
+		if errors.Is(err, repository.ErrProductNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Product not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to set product units",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, product.ToResponse())
+}
+
+// writeUnitError writes the response for errors raised when a quantity is
+// given in a unit the product does not have, units are defined twice, a unit
+// factor is finer than it can be stored or a quantity is finer than the
+// product's precision. It reports whether err was
+// one of them.
+func writeUnitError(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, repository.ErrUnknownUnit):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Unit is not defined for the product",
+		})
+	case errors.Is(err, repository.ErrDuplicateUnit):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Unit names must be unique and differ from the base unit",
+		})
+	case errors.Is(err, repository.ErrUnitFactor):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Unit factor must be at least 0.001 with at most 3 decimal places",
+		})
+	case errors.Is(err, repository.ErrQuantityPrecision):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Quantity has more decimal places than the product's quantity precision allows",
+		})
+	default:
+		return false
+	}
+	return true
+}
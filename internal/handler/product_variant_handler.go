@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type ProductVariantHandler struct {
+	variantService service.ProductVariantService
+}
+
+func NewProductVariantHandler(variantService service.ProductVariantService) *ProductVariantHandler {
+	return &ProductVariantHandler{variantService: variantService}
+}
+
+// List godoc
+// @Summary      List product variants
+// @Description  Get a parent product's option axes and its variants
+// @Tags         variants
+// @Produce      json
+// @Param        id path int true "Parent product ID"
+// @Success      200  {object}  models.VariantMatrixResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /products/{id}/variants [get]
+func (h *ProductVariantHandler) List(c *gin.Context) {
+	id, ok := parseVariantParentID(c)
+	if !ok {
+		return
+	}
+
+	parent, variants, err := h.variantService.ListVariants(id)
+	if err != nil {
+		writeVariantError(c, err, "Failed to retrieve variants")
+		return
+	}
+
+	c.JSON(http.StatusOK, variantMatrix(parent, variants, 0))
+}
+
+// Generate godoc
+// @Summary      Generate variant matrix
+// @Description  Add option values to a parent product's axes and create a variant for every combination that does not have one yet, restoring deleted ones. The matrix is capped at 500 variants. Variant SKUs are the parent SKU suffixed with the values. (admin only)
+// @Tags         variants
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Parent product ID"
+// @Param        request body models.GenerateVariantsRequest true "Option axes and values"
+// @Success      200  {object}  models.VariantMatrixResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /products/{id}/variants/generate [post]
+func (h *ProductVariantHandler) Generate(c *gin.Context) {
+	id, ok := parseVariantParentID(c)
+	if !ok {
+		return
+	}
+
+	var req models.GenerateVariantsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	parent, variants, created, err := h.variantService.Generate(id, &req)
+	if err != nil {
+		writeVariantError(c, err, "Failed to generate variants")
+		return
+	}
+
+	c.JSON(http.StatusOK, variantMatrix(parent, variants, created))
+}
+
+// variantMatrix builds the response for a parent and its variants
+func variantMatrix(parent *models.Product, variants []models.Product, created int) models.VariantMatrixResponse {
+	parentResponse := parent.ToResponse()
+	response := models.VariantMatrixResponse{
+		ParentID: parent.ID,
+		Options:  parentResponse.Options,
+		Variants: make([]models.ProductResponse, len(variants)),
+		Created:  created,
+	}
+	if response.Options == nil {
+		response.Options = []models.ProductOptionResponse{}
+	}
+	for i, v := range variants {
+		response.Variants[i] = v.ToResponse()
+	}
+	return response
+}
+
+// parseVariantParentID reads the :id path parameter, writing a 400 response
+// if it is invalid
+func parseVariantParentID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid product ID",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// writeVariantError maps variant errors to HTTP responses
+func writeVariantError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, repository.ErrProductNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Product not found",
+		})
+	case errors.Is(err, repository.ErrVariantParent):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Variants cannot have variants of their own",
+		})
+	case errors.Is(err, repository.ErrVariantOptionsMismatch):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Options must name each of the product's option axes exactly once",
+		})
+	case errors.Is(err, repository.ErrTooManyVariants):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	case errors.Is(err, repository.ErrVariantSKUTooLong):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Generated variant SKU exceeds 50 characters",
+		})
+	case errors.Is(err, repository.ErrProductSKUExists):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "A generated variant SKU is already used by another product",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: message,
+		})
+	}
+}
@@ -0,0 +1,380 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type PurchaseOrderHandler struct {
+	orderService service.PurchaseOrderService
+}
+
+func NewPurchaseOrderHandler(orderService service.PurchaseOrderService) *PurchaseOrderHandler {
+	return &PurchaseOrderHandler{orderService: orderService}
+}
+
+// List godoc
+// @Summary      List purchase orders
+// @Description  Get paginated list of purchase orders, newest first (admin only)
+// @Tags         purchase-orders
+// @Produce      json
+// @Param        supplier_id query int false "Filter by supplier ID"
+// @Param        status query string false "Filter by status (draft, sent, partially_received, received, closed)"
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /purchase-orders [get]
+func (h *PurchaseOrderHandler) List(c *gin.Context) {
+	var query models.PurchaseOrderListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page := query.GetPage()
+	pageSize := query.GetPageSize()
+
+	var supplierID *uint
+	if query.SupplierID > 0 {
+		supplierID = &query.SupplierID
+	}
+
+	orders, total, err := h.orderService.List(supplierID, query.Status, page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve purchase orders",
+		})
+		return
+	}
+
+	responses := make([]models.PurchaseOrderResponse, len(orders))
+	for i, o := range orders {
+		responses[i] = o.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
+}
+
+// Get godoc
+// @Summary      Get purchase order by ID
+// @Description  Get a single purchase order with its lines (admin only)
+// @Tags         purchase-orders
+// @Produce      json
+// @Param        id path int true "Purchase order ID"
+// @Success      200  {object}  models.PurchaseOrderResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /purchase-orders/{id} [get]
+func (h *PurchaseOrderHandler) Get(c *gin.Context) {
+	id, ok := parsePurchaseOrderID(c)
+	if !ok {
+		return
+	}
+
+	order, err := h.orderService.GetByID(id)
+	if err != nil {
+		writePurchaseOrderError(c, err, "Failed to retrieve purchase order")
+		return
+	}
+
+	c.JSON(http.StatusOK, order.ToResponse())
+}
+
+// Create godoc
+// @Summary      Create purchase order
+// @Description  Create a draft purchase order for a supplier (admin only)
+// @Tags         purchase-orders
+// @Accept       json
+// @Produce      json
+// @Param        request body models.CreatePurchaseOrderRequest true "Purchase order data"
+// @Success      201  {object}  models.PurchaseOrderResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /purchase-orders [post]
+func (h *PurchaseOrderHandler) Create(c *gin.Context) {
+	var req models.CreatePurchaseOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	order, err := h.orderService.Create(&req, c.GetUint("userID"))
+	if err != nil {
+		writePurchaseOrderError(c, err, "Failed to create purchase order")
+		return
+	}
+
+	c.JSON(http.StatusCreated, order.ToResponse())
+}
+
+// Update godoc
+// @Summary      Update purchase order
+// @Description  Update a draft purchase order; lines, when given, replace the existing ones (admin only)
+// @Tags         purchase-orders
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Purchase order ID"
+// @Param        request body models.UpdatePurchaseOrderRequest true "Purchase order data"
+// @Success      200  {object}  models.PurchaseOrderResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /purchase-orders/{id} [put]
+func (h *PurchaseOrderHandler) Update(c *gin.Context) {
+	id, ok := parsePurchaseOrderID(c)
+	if !ok {
+		return
+	}
+
+	var req models.UpdatePurchaseOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	order, err := h.orderService.Update(id, &req)
+	if err != nil {
+		writePurchaseOrderError(c, err, "Failed to update purchase order")
+		return
+	}
+
+	c.JSON(http.StatusOK, order.ToResponse())
+}
+
+// Delete godoc
+// @Summary      Delete purchase order
+// @Description  Delete a draft purchase order (admin only)
+// @Tags         purchase-orders
+// @Produce      json
+// @Param        id path int true "Purchase order ID"
+// @Success      200  {object}  models.SuccessResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /purchase-orders/{id} [delete]
+func (h *PurchaseOrderHandler) Delete(c *gin.Context) {
+	id, ok := parsePurchaseOrderID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.orderService.Delete(id); err != nil {
+		writePurchaseOrderError(c, err, "Failed to delete purchase order")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse{
+		Message: "Purchase order deleted successfully",
+	})
+}
+
+// Send godoc
+// @Summary      Send purchase order
+// @Description  Mark a draft purchase order as sent to the supplier (admin only)
+// @Tags         purchase-orders
+// @Produce      json
+// @Param        id path int true "Purchase order ID"
+// @Success      200  {object}  models.PurchaseOrderResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /purchase-orders/{id}/send [post]
+func (h *PurchaseOrderHandler) Send(c *gin.Context) {
+	id, ok := parsePurchaseOrderID(c)
+	if !ok {
+		return
+	}
+
+	order, err := h.orderService.Send(id)
+	if err != nil {
+		writePurchaseOrderError(c, err, "Failed to send purchase order")
+		return
+	}
+
+	c.JSON(http.StatusOK, order.ToResponse())
+}
+
+// Receive godoc
+// @Summary      Receive purchase order
+// @Description  Receive goods against a sent purchase order, adding them to stock at its warehouse (admin only)
+// @Tags         purchase-orders
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Purchase order ID"
+// @Param        request body models.ReceivePurchaseOrderRequest true "Quantities received per line"
+// @Success      200  {object}  models.PurchaseOrderResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /purchase-orders/{id}/receive [post]
+func (h *PurchaseOrderHandler) Receive(c *gin.Context) {
+	id, ok := parsePurchaseOrderID(c)
+	if !ok {
+		return
+	}
+
+	var req models.ReceivePurchaseOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	order, err := h.orderService.Receive(id, &req, c.GetUint("userID"))
+	if err != nil {
+		writePurchaseOrderError(c, err, "Failed to receive purchase order")
+		return
+	}
+
+	c.JSON(http.StatusOK, order.ToResponse())
+}
+
+// Close godoc
+// @Summary      Close purchase order
+// @Description  Close a sent or received purchase order; quantities not yet received are abandoned (admin only)
+// @Tags         purchase-orders
+// @Produce      json
+// @Param        id path int true "Purchase order ID"
+// @Success      200  {object}  models.PurchaseOrderResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /purchase-orders/{id}/close [post]
+func (h *PurchaseOrderHandler) Close(c *gin.Context) {
+	id, ok := parsePurchaseOrderID(c)
+	if !ok {
+		return
+	}
+
+	order, err := h.orderService.Close(id)
+	if err != nil {
+		writePurchaseOrderError(c, err, "Failed to close purchase order")
+		return
+	}
+
+	c.JSON(http.StatusOK, order.ToResponse())
+}
+
+// parsePurchaseOrderID reads the :id path parameter, writing a 400 response
+// if it is invalid
+func parsePurchaseOrderID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid purchase order ID",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// writePurchaseOrderError maps purchase order errors to HTTP responses
+func writePurchaseOrderError(c *gin.Context, err error, message string) {
+	if writeSerialError(c, err) || writeUnitError(c, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, repository.ErrPurchaseOrderNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Purchase order not found",
+		})
+	case errors.Is(err, repository.ErrPurchaseOrderLineNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Purchase order line not found",
+		})
+	case errors.Is(err, repository.ErrSupplierNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Supplier not found",
+		})
+	case errors.Is(err, repository.ErrWarehouseNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Warehouse not found",
+		})
+	case errors.Is(err, repository.ErrProductNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Product not found",
+		})
+	case errors.Is(err, repository.ErrLotRequired):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "A lot number is required to receive a lot-tracked product",
+		})
+	case errors.Is(err, repository.ErrCostCurrency):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Line products must be costed in the order's currency",
+		})
+	case errors.Is(err, repository.ErrPurchaseOrderStatus):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Purchase order status does not allow this action",
+		})
+	case errors.Is(err, repository.ErrOverReceipt):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Received quantity exceeds the outstanding quantity",
+		})
+	case errors.Is(err, repository.ErrNoDefaultWarehouse):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "No default warehouse configured",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: message,
+		})
+	}
+}
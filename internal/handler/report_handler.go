@@ -0,0 +1,386 @@
+package handler
+
+import (
+	"encoding/csv"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type ReportHandler struct {
+	valuationService service.ValuationService
+	forecastService  service.ForecastService
+	analysisService  service.StockAnalysisService
+}
+
+func NewReportHandler(valuationService service.ValuationService, forecastService service.ForecastService, analysisService service.StockAnalysisService) *ReportHandler {
+	return &ReportHandler{
+		valuationService: valuationService,
+		forecastService:  forecastService,
+		analysisService:  analysisService,
+	}
+}
+
+// Valuation godoc
+// @Summary      Inventory valuation
+// @Description  Value the stock held at a date and the cost of goods sold over a period, by category, using FIFO or weighted-average costing (admin only). Stock in transit between warehouses is included.
+// @Tags         reports
+// @Produce      json
+// @Param        as_of query string false "Value stock as of this date (YYYY-MM-DD, through the end of the day, or RFC3339); defaults to now"
+// @Param        from query string false "Start of the cost of goods sold period (YYYY-MM-DD or RFC3339); defaults to the start of the as-of month"
+// @Param        method query string false "Costing method (fifo, average); defaults to the configured method"
+// @Param        category_id query int false "Filter by primary category"
+// @Success      200  {object}  models.ValuationReport
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /reports/valuation [get]
+func (h *ReportHandler) Valuation(c *gin.Context) {
+	var query models.ValuationQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	asOf, err := parseDate(query.AsOf)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid as_of date format. Use YYYY-MM-DD or RFC3339",
+		})
+		return
+	}
+	// A plain date values stock at the end of that day
+	if asOf != nil && len(query.AsOf) == len("2006-01-02") {
+		endOfDay := asOf.Add(24*time.Hour - time.Nanosecond)
+		asOf = &endOfDay
+	}
+
+	from, err := parseDate(query.From)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid from date format. Use YYYY-MM-DD or RFC3339",
+		})
+		return
+	}
+
+	var categoryID *uint
+	if query.CategoryID > 0 {
+		categoryID = &query.CategoryID
+	}
+
+	report, err := h.valuationService.Report(query.Method, from, asOf, categoryID)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidValuationPeriod) {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation_error",
+				Message: err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to compute inventory valuation",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// Replenishment godoc
+// @Summary      Replenishment report
+// @Description  Forecast daily demand per product from the stock decreases in its history and suggest reorder quantities. A product is listed when its available stock plus stock on order is at or below its reorder level, the demand over the lead time plus safety stock; the suggestion tops it up to the reorder level plus cover_days of demand. Unset settings take their configured defaults. (admin only)
+// @Tags         reports
+// @Produce      json
+// @Param        method query string false "Forecasting method (moving_average, exponential)"
+// @Param        window_days query int false "Days of history to forecast from"
+// @Param        alpha query number false "Smoothing factor for exponential smoothing (0-1]"
+// @Param        lead_time_days query int false "Supplier lead time in days"
+// @Param        safety_stock_days query int false "Safety stock in days of demand"
+// @Param        cover_days query int false "Days of demand a suggested order covers beyond the reorder level"
+// @Param        category_id query int false "Filter by primary category"
+// @Param        all query bool false "List every product, not only those that need ordering"
+// @Success      200  {object}  models.ReplenishmentReport
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /reports/replenishment [get]
+func (h *ReportHandler) Replenishment(c *gin.Context) {
+	var query models.ReplenishmentQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	report, err := h.forecastService.Replenishment(&query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to compute replenishment report",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// ABC godoc
+// @Summary      ABC analysis
+// @Description  Rank the products costed in a currency by the average value of the stock they held over a window, or for turnover by the cost of the stock they used up, both read from product history, and class them A, B or C by their cumulative share of the total (admin only)
+// @Tags         reports
+// @Produce      json
+// @Produce      text/csv
+// @Param        basis query string false "Ranking basis (value, turnover)" default(value)
+// @Param        currency query string false "ISO 4217 currency of the products to rank" default(USD)
+// @Param        window_days query int false "Days of history to analyse" default(90)
+// @Param        a_share query number false "Cumulative percentage of the total making up class A" default(80)
+// @Param        b_share query number false "Cumulative percentage of the total making up classes A and B" default(95)
+// @Param        category_id query int false "Filter by primary category"
+// @Param        format query string false "Response format (json, csv)" default(json)
+// @Success      200  {object}  models.ABCReport
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /reports/abc [get]
+func (h *ReportHandler) ABC(c *gin.Context) {
+	var query models.ABCQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	report, err := h.analysisService.ABC(&query)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidABCShares) {
+			c.JSON(http.StatusBadRequest, models.ErrorResponse{
+				Error:   "validation_error",
+				Message: err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to compute ABC analysis",
+		})
+		return
+	}
+
+	if query.Format == "csv" {
+		rows := make([][]string, len(report.Lines))
+		for i, line := range report.Lines {
+			rows[i] = []string{
+				strconv.Itoa(line.Rank),
+				line.Class,
+				strconv.FormatUint(uint64(line.ProductID), 10),
+				line.SKU,
+				line.Name,
+				line.CategoryName,
+				line.AverageStock.String(),
+				line.StockValue.String(),
+				line.Usage.String(),
+				line.UsageValue.String(),
+				csvOptionalFloat(line.Turnover),
+				csvFloat(line.Share),
+				csvFloat(line.CumulativeShare),
+			}
+		}
+		writeCSV(c, "abc-analysis.csv", []string{
+			"rank", "class", "product_id", "sku", "name", "category", "average_stock", "stock_value",
+			"usage", "usage_value", "turnover", "share", "cumulative_share",
+		}, rows)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// DeadStock godoc
+// @Summary      Dead-stock report
+// @Description  List products holding stock that have had no stock movement in the last days, by stock value at average cost, highest first (admin only)
+// @Tags         reports
+// @Produce      json
+// @Produce      text/csv
+// @Param        days query int false "Days without movement" default(90)
+// @Param        category_id query int false "Filter by primary category"
+// @Param        format query string false "Response format (json, csv)" default(json)
+// @Success      200  {object}  models.DeadStockReport
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /reports/dead-stock [get]
+func (h *ReportHandler) DeadStock(c *gin.Context) {
+	var query models.DeadStockQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	report, err := h.analysisService.DeadStock(&query)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to compute dead-stock report",
+		})
+		return
+	}
+
+	if query.Format == "csv" {
+		rows := make([][]string, len(report.Lines))
+		for i, line := range report.Lines {
+			var lastMovement, daysIdle string
+			if line.LastMovementAt != nil {
+				lastMovement = line.LastMovementAt.Format(time.RFC3339)
+			}
+			if line.DaysIdle != nil {
+				daysIdle = strconv.Itoa(*line.DaysIdle)
+			}
+			rows[i] = []string{
+				strconv.FormatUint(uint64(line.ProductID), 10),
+				line.SKU,
+				line.Name,
+				line.CategoryName,
+				line.Stock.String(),
+				line.Currency,
+				line.AverageCost.String(),
+				line.StockValue.String(),
+				lastMovement,
+				daysIdle,
+			}
+		}
+		writeCSV(c, "dead-stock.csv", []string{
+			"product_id", "sku", "name", "category", "stock", "currency", "average_cost", "stock_value",
+			"last_movement_at", "days_idle",
+		}, rows)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// Snapshot godoc
+// @Summary      Inventory snapshot
+// @Description  List every product's stock and price as of a past time, from its latest history row at or before it, with the units held and their value at list price per currency. Products deleted since are included; products without history by then are not. (admin only)
+// @Tags         reports
+// @Produce      json
+// @Produce      text/csv
+// @Param        at query string true "Snapshot time (YYYY-MM-DD, through the end of the day, or RFC3339)"
+// @Param        category_id query int false "Filter by primary category"
+// @Param        format query string false "Response format (json, csv)" default(json)
+// @Success      200  {object}  models.SnapshotReport
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /reports/snapshot [get]
+func (h *ReportHandler) Snapshot(c *gin.Context) {
+	var query models.SnapshotQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	at, err := parseDate(query.At)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid at date format. Use YYYY-MM-DD or RFC3339",
+		})
+		return
+	}
+	// A plain date takes the stock at the end of that day
+	if len(query.At) == len("2006-01-02") {
+		endOfDay := at.Add(24*time.Hour - time.Nanosecond)
+		at = &endOfDay
+	}
+
+	var categoryID *uint
+	if query.CategoryID > 0 {
+		categoryID = &query.CategoryID
+	}
+
+	report, err := h.analysisService.Snapshot(*at, categoryID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to compute inventory snapshot",
+		})
+		return
+	}
+
+	if query.Format == "csv" {
+		rows := make([][]string, len(report.Lines))
+		for i, line := range report.Lines {
+			rows[i] = []string{
+				strconv.FormatUint(uint64(line.ProductID), 10),
+				line.SKU,
+				line.Name,
+				line.CategoryName,
+				line.Stock.String(),
+				line.Price.String(),
+				line.Currency,
+				line.ChangedAt.Format(time.RFC3339),
+			}
+		}
+		writeCSV(c, "snapshot-"+at.Format("2006-01-02")+".csv", []string{
+			"product_id", "sku", "name", "category", "stock", "price", "currency", "changed_at",
+		}, rows)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// writeCSV writes a report as a CSV attachment with a header row
+func writeCSV(c *gin.Context, filename string, header []string, rows [][]string) {
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", `attachment; filename="`+filename+`"`)
+	c.Status(http.StatusOK)
+
+	w := csv.NewWriter(c.Writer)
+	_ = w.Write(header)
+	_ = w.WriteAll(rows)
+}
+
+// csvFloat renders a number for a CSV cell without trailing zeros
+func csvFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// csvOptionalFloat renders an optional number for a CSV cell, empty if unset
+func csvOptionalFloat(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return csvFloat(*v)
+}
@@ -0,0 +1,328 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type ReservationHandler struct {
+	reservationService service.ReservationService
+}
+
+func NewReservationHandler(reservationService service.ReservationService) *ReservationHandler {
+	return &ReservationHandler{reservationService: reservationService}
+}
+
+// List godoc
+// @Summary      List reservations
+// @Description  Get paginated list of stock reservations with optional product and status filters
+// @Tags         reservations
+// @Produce      json
+// @Param        product_id query int false "Filter by product ID"
+// @Param        status query string false "Filter by status (active, confirmed, released, expired)"
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /reservations [get]
+func (h *ReservationHandler) List(c *gin.Context) {
+	var query models.ReservationListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page := query.GetPage()
+	pageSize := query.GetPageSize()
+
+	var productID *uint
+	if query.ProductID > 0 {
+		productID = &query.ProductID
+	}
+
+	reservations, total, err := h.reservationService.List(productID, query.Status, page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve reservations",
+		})
+		return
+	}
+
+	responses := make([]models.ReservationResponse, len(reservations))
+	for i, r := range reservations {
+		responses[i] = r.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
+}
+
+// Get godoc
+// @Summary      Get reservation by ID
+// @Description  Get a single stock reservation by its ID
+// @Tags         reservations
+// @Produce      json
+// @Param        id path int true "Reservation ID"
+// @Success      200  {object}  models.ReservationResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /reservations/{id} [get]
+func (h *ReservationHandler) Get(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid reservation ID",
+		})
+		return
+	}
+
+	reservation, err := h.reservationService.GetByID(uint(id))
+	if err != nil {
+		if errors.Is(err, repository.ErrReservationNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Reservation not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve reservation",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, reservation.ToResponse())
+}
+
+// Create godoc
+// @Summary      Create reservation
+// @Description  Hold available stock of a product for a limited time (admin only)
+// @Tags         reservations
+// @Accept       json
+// @Produce      json
+// @Param        request body models.CreateReservationRequest true "Reservation data"
+// @Success      201  {object}  models.ReservationResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /reservations [post]
+func (h *ReservationHandler) Create(c *gin.Context) {
+	var req models.CreateReservationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	reservation, err := h.reservationService.Create(&req, c.GetUint("userID"))
+	if err != nil {
+		if writeUnitError(c, err) {
+			return
+		}
+		if errors.Is(err, repository.ErrProductNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Product not found",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrInsufficientAvailability) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Not enough available stock to reserve",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to create reservation",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, reservation.ToResponse())
+}
+
+// Confirm godoc
+// @Summary      Confirm reservation
+// @Description  Convert an active reservation into a sale, decrementing stock (admin only)
+// @Tags         reservations
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Reservation ID"
+// @Param        request body models.ConfirmReservationRequest false "Warehouse to take stock from and serial numbers sold"
+// @Success      200  {object}  models.ReservationResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /reservations/{id}/confirm [post]
+func (h *ReservationHandler) Confirm(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid reservation ID",
+		})
+		return
+	}
+
+	// The request body is optional
+	var req models.ConfirmReservationRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	reservation, err := h.reservationService.Confirm(uint(id), &req, c.GetUint("userID"))
+	if err != nil {
+		if writeSerialError(c, err) {
+			return
+		}
+		if errors.Is(err, repository.ErrReservationNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Reservation not found",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrWarehouseNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Warehouse not found",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrReservationNotActive) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Reservation is no longer active",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrReservationOwnedByOrder) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Reservation belongs to a sales order; ship or cancel the order instead",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrReservationExpired) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Reservation has expired",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrInsufficientStock) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Insufficient stock at this warehouse",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrLotExpired) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Only expired lots are left to cover the quantity",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrInsufficientAvailability) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Stock is held by other reservations",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to confirm reservation",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, reservation.ToResponse())
+}
+
+// Release godoc
+// @Summary      Release reservation
+// @Description  Release an active reservation, making its stock available again (admin only)
+// @Tags         reservations
+// @Produce      json
+// @Param        id path int true "Reservation ID"
+// @Success      200  {object}  models.ReservationResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /reservations/{id}/release [post]
+func (h *ReservationHandler) Release(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid reservation ID",
+		})
+		return
+	}
+
+	reservation, err := h.reservationService.Release(uint(id))
+	if err != nil {
+		if errors.Is(err, repository.ErrReservationNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Reservation not found",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrReservationNotActive) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Reservation is no longer active",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrReservationOwnedByOrder) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Reservation belongs to a sales order; ship or cancel the order instead",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to release reservation",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, reservation.ToResponse())
+}
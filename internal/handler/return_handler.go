@@ -0,0 +1,314 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type ReturnHandler struct {
+	returnService service.ReturnService
+}
+
+func NewReturnHandler(returnService service.ReturnService) *ReturnHandler {
+	return &ReturnHandler{returnService: returnService}
+}
+
+// List godoc
+// @Summary      List returns
+// @Description  Get paginated list of customer returns (RMAs), newest first
+// @Tags         returns
+// @Produce      json
+// @Param        product_id query int false "Filter by product ID"
+// @Param        status query string false "Filter by status (pending, inspected, disposed)"
+// @Param        disposition query string false "Filter by disposition (restock, refurbish, scrap)"
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /returns [get]
+func (h *ReturnHandler) List(c *gin.Context) {
+	var query models.ReturnListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page := query.GetPage()
+	pageSize := query.GetPageSize()
+
+	returns, total, err := h.returnService.List(query.ProductID, query.Status, query.Disposition, page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve returns",
+		})
+		return
+	}
+
+	responses := make([]models.ReturnResponse, len(returns))
+	for i, r := range returns {
+		responses[i] = r.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
+}
+
+// Summary godoc
+// @Summary      Returns summary
+// @Description  Count returns and returned units created in a period by status, inspected condition and disposition, with the share of disposed units restocked
+// @Tags         returns
+// @Produce      json
+// @Param        product_id query int false "Only returns of this product"
+// @Param        start query string false "Start date (YYYY-MM-DD or RFC3339)"
+// @Param        end query string false "End date (YYYY-MM-DD or RFC3339)"
+// @Success      200  {object}  models.ReturnSummary
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /returns/summary [get]
+func (h *ReturnHandler) Summary(c *gin.Context) {
+	var query models.ReturnSummaryQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	start, err := parseDate(query.Start)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid start date format. Use YYYY-MM-DD or RFC3339",
+		})
+		return
+	}
+	end, err := parseDate(query.End)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid end date format. Use YYYY-MM-DD or RFC3339",
+		})
+		return
+	}
+
+	summary, err := h.returnService.Summary(query.ProductID, start, end)
+	if err != nil {
+		writeReturnError(c, err, "Failed to summarize returns")
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+// Get godoc
+// @Summary      Get return by ID
+// @Description  Get a single return with its processing history
+// @Tags         returns
+// @Produce      json
+// @Param        id path int true "Return ID"
+// @Success      200  {object}  models.ReturnResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /returns/{id} [get]
+func (h *ReturnHandler) Get(c *gin.Context) {
+	id, ok := parseReturnID(c)
+	if !ok {
+		return
+	}
+
+	ret, err := h.returnService.GetByID(id)
+	if err != nil {
+		writeReturnError(c, err, "Failed to retrieve return")
+		return
+	}
+
+	c.JSON(http.StatusOK, ret.ToResponse())
+}
+
+// Create godoc
+// @Summary      Create return
+// @Description  Record a pending customer return of a product. Serialized products are returned one unit at a time and must name a unit that is not in stock. No stock changes until the return is restocked. (admin only)
+// @Tags         returns
+// @Accept       json
+// @Produce      json
+// @Param        request body models.CreateReturnRequest true "Return data"
+// @Success      201  {object}  models.ReturnResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /returns [post]
+func (h *ReturnHandler) Create(c *gin.Context) {
+	var req models.CreateReturnRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ret, err := h.returnService.Create(&req, c.GetUint("userID"))
+	if err != nil {
+		writeReturnError(c, err, "Failed to create return")
+		return
+	}
+
+	c.JSON(http.StatusCreated, ret.ToResponse())
+}
+
+// Inspect godoc
+// @Summary      Inspect return
+// @Description  Record the condition a pending return was found in (admin only)
+// @Tags         returns
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Return ID"
+// @Param        request body models.InspectReturnRequest true "Inspection result"
+// @Success      200  {object}  models.ReturnResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /returns/{id}/inspect [post]
+func (h *ReturnHandler) Inspect(c *gin.Context) {
+	id, ok := parseReturnID(c)
+	if !ok {
+		return
+	}
+
+	var req models.InspectReturnRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ret, err := h.returnService.Inspect(id, &req, c.GetUint("userID"))
+	if err != nil {
+		writeReturnError(c, err, "Failed to inspect return")
+		return
+	}
+
+	c.JSON(http.StatusOK, ret.ToResponse())
+}
+
+// Dispose godoc
+// @Summary      Dispose of return
+// @Description  Settle an inspected return. restock records a return movement into the warehouse (default warehouse if omitted); refurbish and scrap leave stock unchanged and move a returned unit to refurbishing or written_off. (admin only)
+// @Tags         returns
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Return ID"
+// @Param        request body models.DisposeReturnRequest true "Disposition"
+// @Success      200  {object}  models.ReturnResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /returns/{id}/dispose [post]
+func (h *ReturnHandler) Dispose(c *gin.Context) {
+	id, ok := parseReturnID(c)
+	if !ok {
+		return
+	}
+
+	var req models.DisposeReturnRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	ret, err := h.returnService.Dispose(id, &req, c.GetUint("userID"))
+	if err != nil {
+		writeReturnError(c, err, "Failed to dispose of return")
+		return
+	}
+
+	c.JSON(http.StatusOK, ret.ToResponse())
+}
+
+// parseReturnID reads the :id path parameter, writing a 400 response if it
+// is invalid
+func parseReturnID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid return ID",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// writeReturnError maps return errors to HTTP responses
+func writeReturnError(c *gin.Context, err error, message string) {
+	if writeSerialError(c, err) || writeUnitError(c, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, repository.ErrReturnNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Return not found",
+		})
+	case errors.Is(err, repository.ErrProductNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Product not found",
+		})
+	case errors.Is(err, repository.ErrWarehouseNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Warehouse not found",
+		})
+	case errors.Is(err, repository.ErrReturnTracking):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Serial and lot numbers only apply to products tracked by them",
+		})
+	case errors.Is(err, repository.ErrReturnStatus):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Return status does not allow this action",
+		})
+	case errors.Is(err, repository.ErrNoDefaultWarehouse):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "No default warehouse configured",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: message,
+		})
+	}
+}
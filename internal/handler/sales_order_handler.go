@@ -0,0 +1,331 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type SalesOrderHandler struct {
+	orderService service.SalesOrderService
+}
+
+func NewSalesOrderHandler(orderService service.SalesOrderService) *SalesOrderHandler {
+	return &SalesOrderHandler{orderService: orderService}
+}
+
+// List godoc
+// @Summary      List sales orders
+// @Description  Get paginated list of sales orders, newest first
+// @Tags         sales-orders
+// @Produce      json
+// @Param        status query string false "Filter by status (pending, allocated, picked, shipped, cancelled)"
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /sales-orders [get]
+func (h *SalesOrderHandler) List(c *gin.Context) {
+	var query models.SalesOrderListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page := query.GetPage()
+	pageSize := query.GetPageSize()
+
+	orders, total, err := h.orderService.List(query.Status, page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve sales orders",
+		})
+		return
+	}
+
+	responses := make([]models.SalesOrderResponse, len(orders))
+	for i, o := range orders {
+		responses[i] = o.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
+}
+
+// Get godoc
+// @Summary      Get sales order by ID
+// @Description  Get a single sales order with its lines
+// @Tags         sales-orders
+// @Produce      json
+// @Param        id path int true "Sales order ID"
+// @Success      200  {object}  models.SalesOrderResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /sales-orders/{id} [get]
+func (h *SalesOrderHandler) Get(c *gin.Context) {
+	id, ok := parseSalesOrderID(c)
+	if !ok {
+		return
+	}
+
+	order, err := h.orderService.GetByID(id)
+	if err != nil {
+		writeSalesOrderError(c, err, "Failed to retrieve sales order")
+		return
+	}
+
+	c.JSON(http.StatusOK, order.ToResponse())
+}
+
+// Create godoc
+// @Summary      Create sales order
+// @Description  Create a pending sales order; no stock is held until it is allocated (admin only)
+// @Tags         sales-orders
+// @Accept       json
+// @Produce      json
+// @Param        request body models.CreateSalesOrderRequest true "Sales order data"
+// @Success      201  {object}  models.SalesOrderResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /sales-orders [post]
+func (h *SalesOrderHandler) Create(c *gin.Context) {
+	var req models.CreateSalesOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	order, err := h.orderService.Create(&req, c.GetUint("userID"))
+	if err != nil {
+		writeSalesOrderError(c, err, "Failed to create sales order")
+		return
+	}
+
+	c.JSON(http.StatusCreated, order.ToResponse())
+}
+
+// Allocate godoc
+// @Summary      Allocate sales order
+// @Description  Reserve available stock for every line of a pending order, all or nothing (admin only)
+// @Tags         sales-orders
+// @Produce      json
+// @Param        id path int true "Sales order ID"
+// @Success      200  {object}  models.SalesOrderResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /sales-orders/{id}/allocate [post]
+func (h *SalesOrderHandler) Allocate(c *gin.Context) {
+	id, ok := parseSalesOrderID(c)
+	if !ok {
+		return
+	}
+
+	order, err := h.orderService.Allocate(id, c.GetUint("userID"))
+	if err != nil {
+		writeSalesOrderError(c, err, "Failed to allocate sales order")
+		return
+	}
+
+	c.JSON(http.StatusOK, order.ToResponse())
+}
+
+// Pick godoc
+// @Summary      Pick sales order
+// @Description  Mark an allocated order as picked (admin only)
+// @Tags         sales-orders
+// @Produce      json
+// @Param        id path int true "Sales order ID"
+// @Success      200  {object}  models.SalesOrderResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /sales-orders/{id}/pick [post]
+func (h *SalesOrderHandler) Pick(c *gin.Context) {
+	id, ok := parseSalesOrderID(c)
+	if !ok {
+		return
+	}
+
+	order, err := h.orderService.Pick(id)
+	if err != nil {
+		writeSalesOrderError(c, err, "Failed to pick sales order")
+		return
+	}
+
+	c.JSON(http.StatusOK, order.ToResponse())
+}
+
+// Ship godoc
+// @Summary      Ship sales order
+// @Description  Ship a picked order, decrementing stock at its warehouse in one transaction. Lines of serialized products must list the units shipped. (admin only)
+// @Tags         sales-orders
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Sales order ID"
+// @Param        request body models.ShipSalesOrderRequest false "Serial numbers shipped per line"
+// @Success      200  {object}  models.SalesOrderResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /sales-orders/{id}/ship [post]
+func (h *SalesOrderHandler) Ship(c *gin.Context) {
+	id, ok := parseSalesOrderID(c)
+	if !ok {
+		return
+	}
+
+	// The request body is optional
+	var req models.ShipSalesOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	order, err := h.orderService.Ship(id, &req, c.GetUint("userID"))
+	if err != nil {
+		writeSalesOrderError(c, err, "Failed to ship sales order")
+		return
+	}
+
+	c.JSON(http.StatusOK, order.ToResponse())
+}
+
+// Cancel godoc
+// @Summary      Cancel sales order
+// @Description  Cancel an order that has not shipped, releasing any allocated stock (admin only)
+// @Tags         sales-orders
+// @Produce      json
+// @Param        id path int true "Sales order ID"
+// @Success      200  {object}  models.SalesOrderResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /sales-orders/{id}/cancel [post]
+func (h *SalesOrderHandler) Cancel(c *gin.Context) {
+	id, ok := parseSalesOrderID(c)
+	if !ok {
+		return
+	}
+
+	order, err := h.orderService.Cancel(id)
+	if err != nil {
+		writeSalesOrderError(c, err, "Failed to cancel sales order")
+		return
+	}
+
+	c.JSON(http.StatusOK, order.ToResponse())
+}
+
+// parseSalesOrderID reads the :id path parameter, writing a 400 response
+// if it is invalid
+func parseSalesOrderID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid sales order ID",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// writeSalesOrderError maps sales order errors to HTTP responses
+func writeSalesOrderError(c *gin.Context, err error, message string) {
+	if writeSerialError(c, err) || writeUnitError(c, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, repository.ErrSalesOrderNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Sales order not found",
+		})
+	case errors.Is(err, repository.ErrProductNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Product not found",
+		})
+	case errors.Is(err, repository.ErrWarehouseNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Warehouse not found",
+		})
+	case errors.Is(err, service.ErrCurrencyMismatch):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	case errors.Is(err, repository.ErrSalesOrderStatus):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Sales order status does not allow this action",
+		})
+	case errors.Is(err, repository.ErrInsufficientAvailability):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Not enough available stock at the order's warehouse to allocate the order",
+		})
+	case errors.Is(err, repository.ErrInsufficientStock):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Insufficient stock at the order's warehouse",
+		})
+	case errors.Is(err, repository.ErrLotExpired):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Only expired lots are left to cover the quantity",
+		})
+	case errors.Is(err, repository.ErrReservationNotActive), errors.Is(err, repository.ErrReservationNotFound):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Stock allocated to the order has been released",
+		})
+	case errors.Is(err, repository.ErrNoDefaultWarehouse):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "No default warehouse configured",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: message,
+		})
+	}
+}
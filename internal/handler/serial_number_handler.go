@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type SerialNumberHandler struct {
+	serialService service.SerialNumberService
+}
+
+func NewSerialNumberHandler(serialService service.SerialNumberService) *SerialNumberHandler {
+	return &SerialNumberHandler{serialService: serialService}
+}
+
+// Trace godoc
+// @Summary      Trace serial number
+// @Description  Get a serialized unit's current status and location with every stock movement that moved it and every status change outside a movement (such as a return sent for refurbishment or scrapped), oldest first
+// @Tags         serials
+// @Produce      json
+// @Param        serial path string true "Serial number"
+// @Success      200  {object}  models.SerialTraceResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /serials/{serial} [get]
+func (h *SerialNumberHandler) Trace(c *gin.Context) {
+	unit, movements, events, err := h.serialService.Trace(c.Param("serial"))
+	if err != nil {
+		if errors.Is(err, repository.ErrSerialNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Serial number not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to trace serial number",
+		})
+		return
+	}
+
+	response := models.SerialTraceResponse{
+		SerialNumberResponse: unit.ToResponse(),
+		Movements:            make([]models.StockMovementResponse, len(movements)),
+		Events:               make([]models.SerialEventResponse, len(events)),
+	}
+	for i, m := range movements {
+		response.Movements[i] = m.ToResponse()
+	}
+	for i, e := range events {
+		response.Events[i] = e.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// writeSerialError writes the response for errors raised when a stock change
+// on a serialized product names the wrong units. It reports whether err was
+// one of them.
+func writeSerialError(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, repository.ErrSerialCountMismatch):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Serialized products must list one distinct serial number per unit moved",
+		})
+	case errors.Is(err, repository.ErrSerialNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Serial number not found",
+		})
+	case errors.Is(err, repository.ErrSerialUnavailable):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Serial number is not in a state that allows this movement",
+		})
+	default:
+		return false
+	}
+	return true
+}
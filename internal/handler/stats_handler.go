@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type StatsHandler struct {
+	statsService service.StatsService
+}
+
+func NewStatsHandler(statsService service.StatsService) *StatsHandler {
+	return &StatsHandler{statsService: statsService}
+}
+
+// Get godoc
+// @Summary      Get inventory stats
+// @Description  Get the dashboard aggregates: SKU and unit counts, stock value at cost and at list price, out-of-stock and low-stock counts, and a breakdown per primary category. Fresh stats are also pushed as stats.updated events.
+// @Tags         stats
+// @Produce      json
+// @Success      200  {object}  models.InventoryStats
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /stats [get]
+func (h *StatsHandler) Get(c *gin.Context) {
+	stats, err := h.statsService.Get()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to compute inventory stats",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, stats)
+}
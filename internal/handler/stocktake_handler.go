@@ -0,0 +1,321 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type StocktakeHandler struct {
+	stocktakeService service.StocktakeService
+}
+
+func NewStocktakeHandler(stocktakeService service.StocktakeService) *StocktakeHandler {
+	return &StocktakeHandler{stocktakeService: stocktakeService}
+}
+
+// List godoc
+// @Summary      List stocktakes
+// @Description  Get paginated list of stocktake count sessions, newest first
+// @Tags         stocktakes
+// @Produce      json
+// @Param        warehouse_id query int false "Filter by warehouse"
+// @Param        category_id query int false "Filter by category"
+// @Param        status query string false "Filter by status (open, approved, rejected)"
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /stocktakes [get]
+func (h *StocktakeHandler) List(c *gin.Context) {
+	var query models.StocktakeListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page := query.GetPage()
+	pageSize := query.GetPageSize()
+
+	var warehouseID, categoryID *uint
+	if query.WarehouseID > 0 {
+		warehouseID = &query.WarehouseID
+	}
+	if query.CategoryID > 0 {
+		categoryID = &query.CategoryID
+	}
+
+	stocktakes, total, err := h.stocktakeService.List(warehouseID, categoryID, query.Status, page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve stocktakes",
+		})
+		return
+	}
+
+	responses := make([]models.StocktakeResponse, len(stocktakes))
+	for i, s := range stocktakes {
+		responses[i] = s.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
+}
+
+// Get godoc
+// @Summary      Get stocktake by ID
+// @Description  Get a single stocktake with its lines, counts and variances
+// @Tags         stocktakes
+// @Produce      json
+// @Param        id path int true "Stocktake ID"
+// @Success      200  {object}  models.StocktakeResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /stocktakes/{id} [get]
+func (h *StocktakeHandler) Get(c *gin.Context) {
+	id, ok := parseStocktakeID(c)
+	if !ok {
+		return
+	}
+
+	stocktake, err := h.stocktakeService.GetByID(id)
+	if err != nil {
+		writeStocktakeError(c, err, "Failed to retrieve stocktake")
+		return
+	}
+
+	c.JSON(http.StatusOK, stocktake.ToResponse())
+}
+
+// Create godoc
+// @Summary      Open stocktake
+// @Description  Open a count session for the products of a category, a warehouse, or a category at a warehouse. A category alone is counted at the default warehouse. (admin only)
+// @Tags         stocktakes
+// @Accept       json
+// @Produce      json
+// @Param        request body models.CreateStocktakeRequest true "Stocktake scope"
+// @Success      201  {object}  models.StocktakeResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /stocktakes [post]
+func (h *StocktakeHandler) Create(c *gin.Context) {
+	var req models.CreateStocktakeRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	stocktake, err := h.stocktakeService.Create(&req, c.GetUint("userID"))
+	if err != nil {
+		writeStocktakeError(c, err, "Failed to open stocktake")
+		return
+	}
+
+	c.JSON(http.StatusCreated, stocktake.ToResponse())
+}
+
+// SubmitCounts godoc
+// @Summary      Submit stocktake counts
+// @Description  Record counted quantities against an open stocktake. The variance of each count is computed against the stock at the time of the count. Counting a product again replaces its earlier count.
+// @Tags         stocktakes
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Stocktake ID"
+// @Param        request body models.SubmitStocktakeCountsRequest true "Counted quantities"
+// @Success      200  {object}  models.StocktakeResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /stocktakes/{id}/counts [post]
+func (h *StocktakeHandler) SubmitCounts(c *gin.Context) {
+	id, ok := parseStocktakeID(c)
+	if !ok {
+		return
+	}
+
+	var req models.SubmitStocktakeCountsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	stocktake, err := h.stocktakeService.SubmitCounts(id, &req, c.GetUint("userID"))
+	if err != nil {
+		writeStocktakeError(c, err, "Failed to submit stocktake counts")
+		return
+	}
+
+	c.JSON(http.StatusOK, stocktake.ToResponse())
+}
+
+// Approve godoc
+// @Summary      Approve stocktake
+// @Description  Post the variance of every counted line as an adjustment movement and close the stocktake, all in one transaction (admin only)
+// @Tags         stocktakes
+// @Produce      json
+// @Param        id path int true "Stocktake ID"
+// @Success      200  {object}  models.StocktakeResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /stocktakes/{id}/approve [post]
+func (h *StocktakeHandler) Approve(c *gin.Context) {
+	id, ok := parseStocktakeID(c)
+	if !ok {
+		return
+	}
+
+	stocktake, err := h.stocktakeService.Approve(id, c.GetUint("userID"))
+	if err != nil {
+		writeStocktakeError(c, err, "Failed to approve stocktake")
+		return
+	}
+
+	c.JSON(http.StatusOK, stocktake.ToResponse())
+}
+
+// Reject godoc
+// @Summary      Reject stocktake
+// @Description  Close an open stocktake without adjusting stock (admin only)
+// @Tags         stocktakes
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Stocktake ID"
+// @Param        request body models.RejectStocktakeRequest false "Reason for rejecting"
+// @Success      200  {object}  models.StocktakeResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /stocktakes/{id}/reject [post]
+func (h *StocktakeHandler) Reject(c *gin.Context) {
+	id, ok := parseStocktakeID(c)
+	if !ok {
+		return
+	}
+
+	var req models.RejectStocktakeRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	stocktake, err := h.stocktakeService.Reject(id, &req, c.GetUint("userID"))
+	if err != nil {
+		writeStocktakeError(c, err, "Failed to reject stocktake")
+		return
+	}
+
+	c.JSON(http.StatusOK, stocktake.ToResponse())
+}
+
+// parseStocktakeID reads the :id path parameter, writing a 400 response if
+// it is invalid
+func parseStocktakeID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid stocktake ID",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// writeStocktakeError maps stocktake errors to HTTP responses
+func writeStocktakeError(c *gin.Context, err error, message string) {
+	if writeUnitError(c, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, repository.ErrStocktakeNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Stocktake not found",
+		})
+	case errors.Is(err, repository.ErrWarehouseNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Warehouse not found",
+		})
+	case errors.Is(err, repository.ErrCategoryNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Category not found",
+		})
+	case errors.Is(err, repository.ErrProductNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Product not found",
+		})
+	case errors.Is(err, repository.ErrStocktakeEmpty):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "No products to count in the stocktake's category or warehouse",
+		})
+	case errors.Is(err, repository.ErrStocktakeProduct):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Product is not part of the stocktake",
+		})
+	case errors.Is(err, repository.ErrStocktakeStatus):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Stocktake is no longer open",
+		})
+	case errors.Is(err, repository.ErrStocktakeNotCounted):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Stocktake has no counted lines to approve",
+		})
+	case errors.Is(err, repository.ErrInsufficientStock):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Variance would take stock at the warehouse below zero",
+		})
+	case errors.Is(err, repository.ErrNoDefaultWarehouse):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "No default warehouse configured",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: message,
+		})
+	}
+}
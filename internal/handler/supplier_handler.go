@@ -0,0 +1,263 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type SupplierHandler struct {
+	supplierService service.SupplierService
+}
+
+func NewSupplierHandler(supplierService service.SupplierService) *SupplierHandler {
+	return &SupplierHandler{supplierService: supplierService}
+}
+
+// List godoc
+// @Summary      List suppliers
+// @Description  Get paginated list of suppliers, optionally filtered by name or contact (admin only)
+// @Tags         suppliers
+// @Produce      json
+// @Param        search query string false "Search by name or contact name"
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /suppliers [get]
+func (h *SupplierHandler) List(c *gin.Context) {
+	var query models.SupplierListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page := query.GetPage()
+	pageSize := query.GetPageSize()
+
+	suppliers, total, err := h.supplierService.List(page, pageSize, query.Search)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve suppliers",
+		})
+		return
+	}
+
+	responses := make([]models.SupplierResponse, len(suppliers))
+	for i, s := range suppliers {
+		responses[i] = s.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
+}
+
+// Get godoc
+// @Summary      Get supplier by ID
+// @Description  Get a single supplier by its ID (admin only)
+// @Tags         suppliers
+// @Produce      json
+// @Param        id path int true "Supplier ID"
+// @Success      200  {object}  models.SupplierResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /suppliers/{id} [get]
+func (h *SupplierHandler) Get(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid supplier ID",
+		})
+		return
+	}
+
+	supplier, err := h.supplierService.GetByID(uint(id))
+	if err != nil {
+		if errors.Is(err, repository.ErrSupplierNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Supplier not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve supplier",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, supplier.ToResponse())
+}
+
+// Create godoc
+// @Summary      Create supplier
+// @Description  Create a new supplier (admin only)
+// @Tags         suppliers
+// @Accept       json
+// @Produce      json
+// @Param        request body models.CreateSupplierRequest true "Supplier data"
+// @Success      201  {object}  models.SupplierResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /suppliers [post]
+func (h *SupplierHandler) Create(c *gin.Context) {
+	var req models.CreateSupplierRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	supplier, err := h.supplierService.Create(&req)
+	if err != nil {
+		if errors.Is(err, repository.ErrSupplierAlreadyExists) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Supplier with this name already exists",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to create supplier",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, supplier.ToResponse())
+}
+
+// Update godoc
+// @Summary      Update supplier
+// @Description  Update an existing supplier (admin only)
+// @Tags         suppliers
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Supplier ID"
+// @Param        request body models.UpdateSupplierRequest true "Supplier data"
+// @Success      200  {object}  models.SupplierResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /suppliers/{id} [put]
+func (h *SupplierHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid supplier ID",
+		})
+		return
+	}
+
+	var req models.UpdateSupplierRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	supplier, err := h.supplierService.Update(uint(id), &req)
+	if err != nil {
+		if errors.Is(err, repository.ErrSupplierNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Supplier not found",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrSupplierAlreadyExists) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Supplier with this name already exists",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to update supplier",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, supplier.ToResponse())
+}
+
+// Delete godoc
+// @Summary      Delete supplier
+// @Description  Delete a supplier that has no purchase orders (admin only)
+// @Tags         suppliers
+// @Produce      json
+// @Param        id path int true "Supplier ID"
+// @Success      200  {object}  models.SuccessResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /suppliers/{id} [delete]
+func (h *SupplierHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid supplier ID",
+		})
+		return
+	}
+
+	err = h.supplierService.Delete(uint(id))
+	if err != nil {
+		if errors.Is(err, repository.ErrSupplierNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Supplier not found",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrSupplierHasOrders) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Cannot delete supplier with purchase orders",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to delete supplier",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse{
+		Message: "Supplier deleted successfully",
+	})
+}
@@ -0,0 +1,276 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type TaxClassHandler struct {
+	taxService service.TaxService
+}
+
+func NewTaxClassHandler(taxService service.TaxService) *TaxClassHandler {
+	return &TaxClassHandler{taxService: taxService}
+}
+
+// List godoc
+// @Summary      List tax classes
+// @Description  Get paginated list of tax classes, optionally filtered by code or name
+// @Tags         tax-classes
+// @Produce      json
+// @Param        search query string false "Search by code or name"
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tax-classes [get]
+func (h *TaxClassHandler) List(c *gin.Context) {
+	var query models.TaxClassQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page := query.GetPage()
+	pageSize := query.GetPageSize()
+
+	classes, total, err := h.taxService.List(page, pageSize, query.Search)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve tax classes",
+		})
+		return
+	}
+
+	responses := make([]models.TaxClassResponse, len(classes))
+	for i, t := range classes {
+		responses[i] = t.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
+}
+
+// Get godoc
+// @Summary      Get tax class by ID
+// @Description  Get a single tax class with its rates per jurisdiction
+// @Tags         tax-classes
+// @Produce      json
+// @Param        id path int true "Tax class ID"
+// @Success      200  {object}  models.TaxClassResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tax-classes/{id} [get]
+func (h *TaxClassHandler) Get(c *gin.Context) {
+	id, ok := parseTaxClassID(c)
+	if !ok {
+		return
+	}
+
+	class, err := h.taxService.GetByID(id)
+	if err != nil {
+		writeTaxClassError(c, err, "Failed to retrieve tax class")
+		return
+	}
+
+	c.JSON(http.StatusOK, class.ToResponse())
+}
+
+// Create godoc
+// @Summary      Create tax class
+// @Description  Create a new tax class (admin only)
+// @Tags         tax-classes
+// @Accept       json
+// @Produce      json
+// @Param        request body models.CreateTaxClassRequest true "Tax class data"
+// @Success      201  {object}  models.TaxClassResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tax-classes [post]
+func (h *TaxClassHandler) Create(c *gin.Context) {
+	var req models.CreateTaxClassRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	class, err := h.taxService.Create(&req)
+	if err != nil {
+		writeTaxClassError(c, err, "Failed to create tax class")
+		return
+	}
+
+	c.JSON(http.StatusCreated, class.ToResponse())
+}
+
+// Update godoc
+// @Summary      Update tax class
+// @Description  Update an existing tax class (admin only)
+// @Tags         tax-classes
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Tax class ID"
+// @Param        request body models.UpdateTaxClassRequest true "Tax class data"
+// @Success      200  {object}  models.TaxClassResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tax-classes/{id} [put]
+func (h *TaxClassHandler) Update(c *gin.Context) {
+	id, ok := parseTaxClassID(c)
+	if !ok {
+		return
+	}
+
+	var req models.UpdateTaxClassRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	class, err := h.taxService.Update(id, &req)
+	if err != nil {
+		writeTaxClassError(c, err, "Failed to update tax class")
+		return
+	}
+
+	c.JSON(http.StatusOK, class.ToResponse())
+}
+
+// Delete godoc
+// @Summary      Delete tax class
+// @Description  Delete a tax class and its rates. Products must be moved off it first. (admin only)
+// @Tags         tax-classes
+// @Produce      json
+// @Param        id path int true "Tax class ID"
+// @Success      200  {object}  models.SuccessResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tax-classes/{id} [delete]
+func (h *TaxClassHandler) Delete(c *gin.Context) {
+	id, ok := parseTaxClassID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.taxService.Delete(id); err != nil {
+		writeTaxClassError(c, err, "Failed to delete tax class")
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse{
+		Message: "Tax class deleted successfully",
+	})
+}
+
+// SetRates godoc
+// @Summary      Set tax class rates
+// @Description  Replace all rates of a tax class. Each jurisdiction may have one rate, a percentage of the net price. (admin only)
+// @Tags         tax-classes
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Tax class ID"
+// @Param        request body models.SetTaxRatesRequest true "Rates"
+// @Success      200  {object}  models.TaxClassResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /tax-classes/{id}/rates [put]
+func (h *TaxClassHandler) SetRates(c *gin.Context) {
+	id, ok := parseTaxClassID(c)
+	if !ok {
+		return
+	}
+
+	var req models.SetTaxRatesRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	class, err := h.taxService.SetRates(id, &req)
+	if err != nil {
+		writeTaxClassError(c, err, "Failed to set tax class rates")
+		return
+	}
+
+	c.JSON(http.StatusOK, class.ToResponse())
+}
+
+// parseTaxClassID reads the tax class ID path parameter, writing a 400
+// response if it is invalid
+func parseTaxClassID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid tax class ID",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// writeTaxClassError maps tax class errors to HTTP responses
+func writeTaxClassError(c *gin.Context, err error, message string) {
+	switch {
+	case errors.Is(err, repository.ErrTaxClassNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Tax class not found",
+		})
+	case errors.Is(err, repository.ErrTaxClassAlreadyExists):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Tax class with this code already exists",
+		})
+	case errors.Is(err, repository.ErrTaxClassInUse):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Tax class is assigned to products",
+		})
+	case errors.Is(err, repository.ErrDuplicateTaxRate):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "A tax class may only have one rate per jurisdiction",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: message,
+		})
+	}
+}
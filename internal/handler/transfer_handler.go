@@ -0,0 +1,302 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type TransferHandler struct {
+	transferService service.TransferService
+}
+
+func NewTransferHandler(transferService service.TransferService) *TransferHandler {
+	return &TransferHandler{transferService: transferService}
+}
+
+// List godoc
+// @Summary      List transfers
+// @Description  Get paginated list of stock transfers, newest first
+// @Tags         transfers
+// @Produce      json
+// @Param        warehouse_id query int false "Filter by source or destination warehouse"
+// @Param        status query string false "Filter by status (draft, in_transit, received, cancelled)"
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /transfers [get]
+func (h *TransferHandler) List(c *gin.Context) {
+	var query models.TransferListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page := query.GetPage()
+	pageSize := query.GetPageSize()
+
+	var warehouseID *uint
+	if query.WarehouseID > 0 {
+		warehouseID = &query.WarehouseID
+	}
+
+	transfers, total, err := h.transferService.List(warehouseID, query.Status, page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve transfers",
+		})
+		return
+	}
+
+	responses := make([]models.TransferResponse, len(transfers))
+	for i, t := range transfers {
+		responses[i] = t.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
+}
+
+// Get godoc
+// @Summary      Get transfer by ID
+// @Description  Get a single stock transfer with its lines
+// @Tags         transfers
+// @Produce      json
+// @Param        id path int true "Transfer ID"
+// @Success      200  {object}  models.TransferResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /transfers/{id} [get]
+func (h *TransferHandler) Get(c *gin.Context) {
+	id, ok := parseTransferID(c)
+	if !ok {
+		return
+	}
+
+	transfer, err := h.transferService.GetByID(id)
+	if err != nil {
+		writeTransferError(c, err, "Failed to retrieve transfer")
+		return
+	}
+
+	c.JSON(http.StatusOK, transfer.ToResponse())
+}
+
+// Create godoc
+// @Summary      Create transfer
+// @Description  Create a draft transfer between two warehouses (admin only)
+// @Tags         transfers
+// @Accept       json
+// @Produce      json
+// @Param        request body models.CreateTransferRequest true "Transfer data"
+// @Success      201  {object}  models.TransferResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /transfers [post]
+func (h *TransferHandler) Create(c *gin.Context) {
+	var req models.CreateTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	transfer, err := h.transferService.Create(&req, c.GetUint("userID"))
+	if err != nil {
+		writeTransferError(c, err, "Failed to create transfer")
+		return
+	}
+
+	c.JSON(http.StatusCreated, transfer.ToResponse())
+}
+
+// Dispatch godoc
+// @Summary      Dispatch transfer
+// @Description  Remove the transfer's stock from the source warehouse and mark it in transit. Lines of serialized products must list the units sent. (admin only)
+// @Tags         transfers
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Transfer ID"
+// @Param        request body models.DispatchTransferRequest false "Serial numbers sent per line"
+// @Success      200  {object}  models.TransferResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /transfers/{id}/dispatch [post]
+func (h *TransferHandler) Dispatch(c *gin.Context) {
+	id, ok := parseTransferID(c)
+	if !ok {
+		return
+	}
+
+	// The request body is optional
+	var req models.DispatchTransferRequest
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	transfer, err := h.transferService.Dispatch(id, &req, c.GetUint("userID"))
+	if err != nil {
+		writeTransferError(c, err, "Failed to dispatch transfer")
+		return
+	}
+
+	c.JSON(http.StatusOK, transfer.ToResponse())
+}
+
+// Receive godoc
+// @Summary      Receive transfer
+// @Description  Add an in-transit transfer's stock at the destination warehouse (admin only)
+// @Tags         transfers
+// @Produce      json
+// @Param        id path int true "Transfer ID"
+// @Success      200  {object}  models.TransferResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /transfers/{id}/receive [post]
+func (h *TransferHandler) Receive(c *gin.Context) {
+	id, ok := parseTransferID(c)
+	if !ok {
+		return
+	}
+
+	transfer, err := h.transferService.Receive(id, c.GetUint("userID"))
+	if err != nil {
+		writeTransferError(c, err, "Failed to receive transfer")
+		return
+	}
+
+	c.JSON(http.StatusOK, transfer.ToResponse())
+}
+
+// Cancel godoc
+// @Summary      Cancel transfer
+// @Description  Cancel a transfer that has not been dispatched (admin only)
+// @Tags         transfers
+// @Produce      json
+// @Param        id path int true "Transfer ID"
+// @Success      200  {object}  models.TransferResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /transfers/{id}/cancel [post]
+func (h *TransferHandler) Cancel(c *gin.Context) {
+	id, ok := parseTransferID(c)
+	if !ok {
+		return
+	}
+
+	transfer, err := h.transferService.Cancel(id)
+	if err != nil {
+		writeTransferError(c, err, "Failed to cancel transfer")
+		return
+	}
+
+	c.JSON(http.StatusOK, transfer.ToResponse())
+}
+
+// parseTransferID reads the :id path parameter, writing a 400 response if
+// it is invalid
+func parseTransferID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid transfer ID",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// writeTransferError maps transfer errors to HTTP responses
+func writeTransferError(c *gin.Context, err error, message string) {
+	if writeSerialError(c, err) || writeUnitError(c, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, repository.ErrTransferNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Transfer not found",
+		})
+	case errors.Is(err, repository.ErrWarehouseNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Warehouse not found",
+		})
+	case errors.Is(err, repository.ErrProductNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Product not found",
+		})
+	case errors.Is(err, repository.ErrTransferSameWarehouse):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Source and destination warehouse must differ",
+		})
+	case errors.Is(err, repository.ErrTransferBundle):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Bundles cannot be transferred; transfer their components instead",
+		})
+	case errors.Is(err, repository.ErrTransferStatus):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Transfer status does not allow this action",
+		})
+	case errors.Is(err, repository.ErrInsufficientStock):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Insufficient stock at the source warehouse",
+		})
+	case errors.Is(err, repository.ErrLotExpired):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Only expired lots are left to cover the quantity",
+		})
+	case errors.Is(err, repository.ErrInsufficientAvailability):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Transfer would take stock held by reservations",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: message,
+		})
+	}
+}
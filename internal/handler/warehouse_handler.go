@@ -0,0 +1,407 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type WarehouseHandler struct {
+	warehouseService service.WarehouseService
+	productService   service.ProductService
+}
+
+func NewWarehouseHandler(warehouseService service.WarehouseService, productService service.ProductService) *WarehouseHandler {
+	return &WarehouseHandler{
+		warehouseService: warehouseService,
+		productService:   productService,
+	}
+}
+
+// List godoc
+// @Summary      List warehouses
+// @Description  Get paginated list of warehouses
+// @Tags         warehouses
+// @Produce      json
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Success      200  {object}  map[string]interface{}
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /warehouses [get]
+func (h *WarehouseHandler) List(c *gin.Context) {
+	var pagination models.PaginationRequest
+	if err := c.ShouldBindQuery(&pagination); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page := pagination.GetPage()
+	pageSize := pagination.GetPageSize()
+
+	warehouses, total, err := h.warehouseService.List(page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve warehouses",
+		})
+		return
+	}
+
+	responses := make([]models.WarehouseResponse, len(warehouses))
+	for i, w := range warehouses {
+		responses[i] = w.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
+}
+
+// Get godoc
+// @Summary      Get warehouse by ID
+// @Description  Get a single warehouse by its ID
+// @Tags         warehouses
+// @Produce      json
+// @Param        id path int true "Warehouse ID"
+// @Success      200  {object}  models.WarehouseResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /warehouses/{id} [get]
+func (h *WarehouseHandler) Get(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid warehouse ID",
+		})
+		return
+	}
+
+	warehouse, err := h.warehouseService.GetByID(uint(id))
+	if err != nil {
+		if errors.Is(err, repository.ErrWarehouseNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Warehouse not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve warehouse",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, warehouse.ToResponse())
+}
+
+// Create godoc
+// @Summary      Create warehouse
+// @Description  Create a new warehouse (admin only)
+// @Tags         warehouses
+// @Accept       json
+// @Produce      json
+// @Param        request body models.CreateWarehouseRequest true "Warehouse data"
+// @Success      201  {object}  models.WarehouseResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /warehouses [post]
+func (h *WarehouseHandler) Create(c *gin.Context) {
+	var req models.CreateWarehouseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	warehouse, err := h.warehouseService.Create(&req)
+	if err != nil {
+		if errors.Is(err, repository.ErrWarehouseAlreadyExists) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Warehouse with this code already exists",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to create warehouse",
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, warehouse.ToResponse())
+}
+
+// Update godoc
+// @Summary      Update warehouse
+// @Description  Update an existing warehouse (admin only)
+// @Tags         warehouses
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Warehouse ID"
+// @Param        request body models.UpdateWarehouseRequest true "Warehouse data"
+// @Success      200  {object}  models.WarehouseResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /warehouses/{id} [put]
+func (h *WarehouseHandler) Update(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid warehouse ID",
+		})
+		return
+	}
+
+	var req models.UpdateWarehouseRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	warehouse, err := h.warehouseService.Update(uint(id), &req)
+	if err != nil {
+		if errors.Is(err, repository.ErrWarehouseNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Warehouse not found",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrWarehouseAlreadyExists) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Warehouse with this code already exists",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to update warehouse",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, warehouse.ToResponse())
+}
+
+// Delete godoc
+// @Summary      Delete warehouse
+// @Description  Delete an empty, non-default warehouse (admin only)
+// @Tags         warehouses
+// @Produce      json
+// @Param        id path int true "Warehouse ID"
+// @Success      200  {object}  models.SuccessResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /warehouses/{id} [delete]
+func (h *WarehouseHandler) Delete(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid warehouse ID",
+		})
+		return
+	}
+
+	err = h.warehouseService.Delete(uint(id))
+	if err != nil {
+		if errors.Is(err, repository.ErrWarehouseNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Warehouse not found",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrWarehouseIsDefault) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Cannot delete the default warehouse",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrWarehouseHasStock) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Cannot delete warehouse that still holds stock",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to delete warehouse",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, models.SuccessResponse{
+		Message: "Warehouse deleted successfully",
+	})
+}
+
+// ListStock godoc
+// @Summary      List warehouse stock
+// @Description  Get paginated stock levels of all products held at a warehouse
+// @Tags         warehouses
+// @Produce      json
+// @Param        id path int true "Warehouse ID"
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /warehouses/{id}/stock [get]
+func (h *WarehouseHandler) ListStock(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid warehouse ID",
+		})
+		return
+	}
+
+	var pagination models.PaginationRequest
+	if err := c.ShouldBindQuery(&pagination); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page := pagination.GetPage()
+	pageSize := pagination.GetPageSize()
+
+	stocks, total, err := h.warehouseService.ListStock(uint(id), page, pageSize)
+	if err != nil {
+		if errors.Is(err, repository.ErrWarehouseNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Warehouse not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve warehouse stock",
+		})
+		return
+	}
+
+	responses := make([]models.ProductStockResponse, len(stocks))
+	for i, s := range stocks {
+		responses[i] = s.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
+}
+
+// UpdateStock godoc
+// @Summary      Set product stock at a warehouse
+// @Description  Set the quantity of a product held at a warehouse; the product's total stock is recalculated (admin only)
+// @Tags         warehouses
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Warehouse ID"
+// @Param        product_id path int true "Product ID"
+// @Param        request body models.UpdateLocationStockRequest true "Stock data"
+// @Success      200  {object}  models.ProductResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /warehouses/{id}/stock/{product_id} [put]
+func (h *WarehouseHandler) UpdateStock(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid warehouse ID",
+		})
+		return
+	}
+
+	productID, err := strconv.ParseUint(c.Param("product_id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid product ID",
+		})
+		return
+	}
+
+	var req models.UpdateLocationStockRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	product, err := h.productService.UpdateStockAt(uint(productID), uint(id), req.Stock, req.Unit, c.GetUint("userID"))
+	if err != nil {
+		if writeSerialError(c, err) || writeUnitError(c, err) {
+			return
+		}
+		if errors.Is(err, repository.ErrBundleAbsoluteStock) {
+			c.JSON(http.StatusConflict, models.ErrorResponse{
+				Error:   "conflict",
+				Message: "Bundle stock follows its components; record a movement instead",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrProductNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Product not found",
+			})
+			return
+		}
+		if errors.Is(err, repository.ErrWarehouseNotFound) {
+			c.JSON(http.StatusNotFound, models.ErrorResponse{
+				Error:   "not_found",
+				Message: "Warehouse not found",
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to update stock",
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, product.ToResponse())
+}
@@ -0,0 +1,296 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/internal/service"
+	"github.com/gin-gonic/gin"
+)
+
+type WorkOrderHandler struct {
+	workOrderService service.WorkOrderService
+}
+
+func NewWorkOrderHandler(workOrderService service.WorkOrderService) *WorkOrderHandler {
+	return &WorkOrderHandler{workOrderService: workOrderService}
+}
+
+// List godoc
+// @Summary      List work orders
+// @Description  Get paginated list of assembly work orders, newest first
+// @Tags         work-orders
+// @Produce      json
+// @Param        product_id query int false "Filter by finished product"
+// @Param        status query string false "Filter by status (draft, in_progress, completed, cancelled)"
+// @Param        page query int false "Page number" default(1)
+// @Param        page_size query int false "Page size" default(10)
+// @Success      200  {object}  map[string]interface{}
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      500  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /work-orders [get]
+func (h *WorkOrderHandler) List(c *gin.Context) {
+	var query models.WorkOrderListQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	page := query.GetPage()
+	pageSize := query.GetPageSize()
+
+	var productID *uint
+	if query.ProductID > 0 {
+		productID = &query.ProductID
+	}
+
+	orders, total, err := h.workOrderService.List(productID, query.Status, page, pageSize)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: "Failed to retrieve work orders",
+		})
+		return
+	}
+
+	responses := make([]models.WorkOrderResponse, len(orders))
+	for i, o := range orders {
+		responses[i] = o.ToResponse()
+	}
+
+	c.JSON(http.StatusOK, models.NewPaginatedResponse(responses, page, pageSize, total))
+}
+
+// Get godoc
+// @Summary      Get work order by ID
+// @Description  Get a single work order with its components and completions
+// @Tags         work-orders
+// @Produce      json
+// @Param        id path int true "Work order ID"
+// @Success      200  {object}  models.WorkOrderResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /work-orders/{id} [get]
+func (h *WorkOrderHandler) Get(c *gin.Context) {
+	id, ok := parseWorkOrderID(c)
+	if !ok {
+		return
+	}
+
+	order, err := h.workOrderService.GetByID(id)
+	if err != nil {
+		writeWorkOrderError(c, err, "Failed to retrieve work order")
+		return
+	}
+
+	c.JSON(http.StatusOK, order.ToResponse())
+}
+
+// Create godoc
+// @Summary      Create work order
+// @Description  Create a draft work order assembling a product from components at a warehouse (admin only)
+// @Tags         work-orders
+// @Accept       json
+// @Produce      json
+// @Param        request body models.CreateWorkOrderRequest true "Work order data"
+// @Success      201  {object}  models.WorkOrderResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /work-orders [post]
+func (h *WorkOrderHandler) Create(c *gin.Context) {
+	var req models.CreateWorkOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	order, err := h.workOrderService.Create(&req, c.GetUint("userID"))
+	if err != nil {
+		writeWorkOrderError(c, err, "Failed to create work order")
+		return
+	}
+
+	c.JSON(http.StatusCreated, order.ToResponse())
+}
+
+// Complete godoc
+// @Summary      Complete work order units
+// @Description  Book built and scrapped units against a work order. Components are consumed for both and built units are added to the finished product's stock, all in one transaction. May be called repeatedly until nothing is outstanding. (admin only)
+// @Tags         work-orders
+// @Accept       json
+// @Produce      json
+// @Param        id path int true "Work order ID"
+// @Param        request body models.CompleteWorkOrderRequest true "Units built and scrapped"
+// @Success      200  {object}  models.WorkOrderResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /work-orders/{id}/complete [post]
+func (h *WorkOrderHandler) Complete(c *gin.Context) {
+	id, ok := parseWorkOrderID(c)
+	if !ok {
+		return
+	}
+
+	var req models.CompleteWorkOrderRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	order, err := h.workOrderService.Complete(id, &req, c.GetUint("userID"))
+	if err != nil {
+		writeWorkOrderError(c, err, "Failed to complete work order")
+		return
+	}
+
+	c.JSON(http.StatusOK, order.ToResponse())
+}
+
+// Cancel godoc
+// @Summary      Cancel work order
+// @Description  Cancel a work order that is not completed. Components already consumed stay consumed. (admin only)
+// @Tags         work-orders
+// @Produce      json
+// @Param        id path int true "Work order ID"
+// @Success      200  {object}  models.WorkOrderResponse
+// @Failure      400  {object}  models.ErrorResponse
+// @Failure      401  {object}  models.ErrorResponse
+// @Failure      403  {object}  models.ErrorResponse
+// @Failure      404  {object}  models.ErrorResponse
+// @Failure      409  {object}  models.ErrorResponse
+// @Security     BearerAuth
+// @Router       /work-orders/{id}/cancel [post]
+func (h *WorkOrderHandler) Cancel(c *gin.Context) {
+	id, ok := parseWorkOrderID(c)
+	if !ok {
+		return
+	}
+
+	order, err := h.workOrderService.Cancel(id)
+	if err != nil {
+		writeWorkOrderError(c, err, "Failed to cancel work order")
+		return
+	}
+
+	c.JSON(http.StatusOK, order.ToResponse())
+}
+
+// parseWorkOrderID reads the :id path parameter, writing a 400 response if
+// it is invalid
+func parseWorkOrderID(c *gin.Context) (uint, bool) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Invalid work order ID",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+// writeWorkOrderError maps work order errors to HTTP responses
+func writeWorkOrderError(c *gin.Context, err error, message string) {
+	if writeSerialError(c, err) || writeUnitError(c, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, repository.ErrWorkOrderNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Work order not found",
+		})
+	case errors.Is(err, repository.ErrWarehouseNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Warehouse not found",
+		})
+	case errors.Is(err, repository.ErrProductNotFound):
+		c.JSON(http.StatusNotFound, models.ErrorResponse{
+			Error:   "not_found",
+			Message: "Product not found",
+		})
+	case errors.Is(err, repository.ErrWorkOrderComponent):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "A product cannot be a component of itself",
+		})
+	case errors.Is(err, repository.ErrWorkOrderBundle):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Bundles cannot be assembled; their stock follows their components",
+		})
+	case errors.Is(err, repository.ErrWorkOrderEmptyCompletion):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Completion must build or scrap at least one unit",
+		})
+	case errors.Is(err, repository.ErrLotRequired):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "A lot number is required to build a lot-tracked product",
+		})
+	case errors.Is(err, repository.ErrCostCurrency):
+		c.JSON(http.StatusBadRequest, models.ErrorResponse{
+			Error:   "validation_error",
+			Message: "Components must be costed in the finished product's currency",
+		})
+	case errors.Is(err, repository.ErrWorkOrderStatus):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Work order status does not allow this action",
+		})
+	case errors.Is(err, repository.ErrOverCompletion):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Completed and scrapped units exceed the outstanding quantity",
+		})
+	case errors.Is(err, repository.ErrInsufficientStock):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Insufficient component stock at the warehouse",
+		})
+	case errors.Is(err, repository.ErrLotExpired):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Only expired lots are left to cover the quantity",
+		})
+	case errors.Is(err, repository.ErrInsufficientAvailability):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "Work order would consume component stock held by reservations",
+		})
+	case errors.Is(err, repository.ErrNoDefaultWarehouse):
+		c.JSON(http.StatusConflict, models.ErrorResponse{
+			Error:   "conflict",
+			Message: "No default warehouse configured",
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, models.ErrorResponse{
+			Error:   "internal_error",
+			Message: message,
+		})
+	}
+}
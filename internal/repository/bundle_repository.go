@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrBundleNesting       = errors.New("bundles cannot contain themselves or other bundles")
+	ErrBundleHasStock      = errors.New("product holds stock of its own")
+	ErrBundleInUse         = errors.New("bundle has active reservations")
+	ErrBundleAbsoluteStock = errors.New("bundle stock follows its components")
+	ErrProductInBundle     = errors.New("product is a component of a bundle")
+)
+
+type BundleRepository interface {
+	SetComponents(bundleID uint, components []models.BundleComponentRequest) error
+}
+
+type bundleRepository struct {
+	db *gorm.DB
+}
+
+func NewBundleRepository(db *gorm.DB) BundleRepository {
+	return &bundleRepository{db: db}
+}
+
+// SetComponents replaces a product's bill of materials. Only products that
+// hold no stock of their own and are not components themselves can become
+// bundles, and a bundle's components cannot change while reservations hold
+// them.
+func (r *bundleRepository) SetComponents(bundleID uint, components []models.BundleComponentRequest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		bundle, err := lockProduct(tx, bundleID)
+		if err != nil {
+			return err
+		}
+
+		var active int64
+		tx.Model(&models.Reservation{}).Where("product_id = ? AND status = ?", bundleID, models.ReservationActive).Count(&active)
+		if active > 0 {
+			return ErrBundleInUse
+		}
+
+		// Merge repeated components into one line each
+		quantities := make(map[uint]models.Quantity, len(components))
+		ids := make([]uint, 0, len(components))
+		for _, component := range components {
+			if component.ProductID == bundleID {
+				return ErrBundleNesting
+			}
+			if _, seen := quantities[component.ProductID]; !seen {
+				ids = append(ids, component.ProductID)
+			}
+			quantities[component.ProductID] += component.Quantity
+		}
+
+		if len(ids) > 0 {
+			if bundle.Stock != 0 || bundle.Reserved != 0 {
+				return ErrBundleHasStock
+			}
+			if err := verifyProductsExist(tx, ids); err != nil {
+				return err
+			}
+
+			var nested int64
+			tx.Model(&models.BundleComponent{}).Where("component_id = ? OR bundle_id IN ?", bundleID, ids).Count(&nested)
+			if nested > 0 {
+				return ErrBundleNesting
+			}
+		}
+
+		if err := tx.Where("bundle_id = ?", bundleID).Delete(&models.BundleComponent{}).Error; err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+		lines := make([]models.BundleComponent, len(ids))
+		for i, id := range ids {
+			lines[i] = models.BundleComponent{
+				BundleID:    bundleID,
+				ComponentID: id,
+				Quantity:    quantities[id],
+			}
+		}
+		return tx.Omit("Component").Create(&lines).Error
+	})
+}
+
+// bundleComponents returns a product's bill of materials with the component
+// products, in component ID order. It is empty for products that are not
+// bundles.
+func bundleComponents(tx *gorm.DB, productID uint) ([]models.BundleComponent, error) {
+	var components []models.BundleComponent
+	err := tx.Preload("Component").Where("bundle_id = ?", productID).Order("component_id ASC").Find(&components).Error
+	if err != nil {
+		return nil, err
+	}
+	return components, nil
+}
+
+// preloadComponents adds a bundle's bill of materials with the component
+// products to a product query
+func preloadComponents(db *gorm.DB) *gorm.DB {
+	return db.Preload("Components", func(db *gorm.DB) *gorm.DB {
+		return db.Order("component_id ASC")
+	}).Preload("Components.Component")
+}
+
+// applyBundleMovement applies a movement of a bundle to its components. Each
+// component moves by the bundle quantity times its quantity in the bill of
+// materials, with a movement and history row of its own linked to the
+// bundle's movement. Lot entries are scaled the same way and serials are
+// routed to the component they belong to. The bundle's movement and history
+// row record the number of bundles the components make up afterwards. It
+// must run inside a transaction with the bundle locked and its warehouse
+// resolved.
+func applyBundleMovement(tx *gorm.DB, bundle *models.Product, components []models.BundleComponent, movement *models.StockMovement) error {
+	serials, err := splitBundleSerials(tx, components, movement.Serials)
+	if err != nil {
+		return err
+	}
+
+	lots := movement.Lots
+	movement.Lots = nil
+	movement.Serials = nil
+	movement.UnitCost = nil
+	movement.CreatedAt = time.Now()
+	if err := tx.Omit(clause.Associations).Create(movement).Error; err != nil {
+		return err
+	}
+
+	reason := fmt.Sprintf("Bundle %s", bundle.SKU)
+	if movement.Reason != "" {
+		reason = fmt.Sprintf("%s (bundle %s)", movement.Reason, bundle.SKU)
+	}
+
+	movement.Components = nil
+	for _, component := range components {
+		child := models.StockMovement{
+			ProductID:   component.ComponentID,
+			WarehouseID: movement.WarehouseID,
+			Type:        movement.Type,
+			Quantity:    movement.Quantity.Mul(component.Quantity),
+			Reason:      reason,
+			Reference:   movement.Reference,
+			UserID:      movement.UserID,
+			ParentID:    &movement.ID,
+			Serials:     serials[component.ComponentID],
+		}
+		for _, entry := range lots {
+			entry.Quantity = entry.Quantity.Mul(component.Quantity)
+			child.Lots = append(child.Lots, entry)
+		}
+		if err := applyStockMovement(tx, &child); err != nil {
+			return err
+		}
+		movement.Components = append(movement.Components, child)
+	}
+
+	err = tx.Raw(`SELECT COALESCE(FLOOR(MIN(p.stock / bc.quantity)), 0) FROM bundle_components bc
+		JOIN products p ON p.id = bc.component_id WHERE bc.bundle_id = ?`, bundle.ID).
+		Scan(&movement.StockAfter).Error
+	if err != nil {
+		return err
+	}
+	if err := tx.Model(movement).UpdateColumn("stock_after", movement.StockAfter).Error; err != nil {
+		return err
+	}
+
+	history := &models.ProductHistory{
+		ProductID:  bundle.ID,
+		Price:      bundle.Price,
+		Currency:   bundle.Currency,
+		Stock:      movement.StockAfter,
+		MovementID: &movement.ID,
+		ChangedAt:  movement.CreatedAt,
+	}
+	return tx.Omit("Product").Create(history).Error
+}
+
+// splitBundleSerials assigns the serials listed on a bundle movement to the
+// serialized components they belong to. Serials not seen before go to the
+// only serialized component, if there is exactly one.
+func splitBundleSerials(tx *gorm.DB, components []models.BundleComponent, entries []models.StockMovementSerial) (map[uint][]models.StockMovementSerial, error) {
+	if len(entries) == 0 {
+		return nil, nil
+	}
+
+	serialized := make(map[uint]bool)
+	var only uint
+	for _, component := range components {
+		if component.Component.Serialized {
+			serialized[component.ComponentID] = true
+			only = component.ComponentID
+		}
+	}
+	if len(serialized) != 1 {
+		only = 0
+	}
+
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.SerialNumber.Serial
+	}
+	var units []models.SerialNumber
+	if err := tx.Where("serial IN ?", names).Find(&units).Error; err != nil {
+		return nil, err
+	}
+	owners := make(map[string]uint, len(units))
+	for _, unit := range units {
+		owners[unit.Serial] = unit.ProductID
+	}
+
+	split := make(map[uint][]models.StockMovementSerial)
+	for _, entry := range entries {
+		productID, known := owners[entry.SerialNumber.Serial]
+		if !known {
+			if only == 0 {
+				return nil, ErrSerialNotFound
+			}
+			productID = only
+		}
+		if !serialized[productID] {
+			return nil, ErrSerialUnavailable
+		}
+		split[productID] = append(split[productID], entry)
+	}
+	return split, nil
+}
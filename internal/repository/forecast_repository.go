@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ForecastRepository interface {
+	Products(categoryID *uint) ([]models.Product, error)
+	DailyDemand(from, to time.Time, location *time.Location, categoryID *uint) ([]models.DailyDemand, error)
+	OnOrder(categoryID *uint) (map[uint]models.Quantity, error)
+}
+
+type forecastRepository struct {
+	db *gorm.DB
+}
+
+func NewForecastRepository(db *gorm.DB) ForecastRepository {
+	return &forecastRepository{db: db}
+}
+
+// Products returns the products that hold stock of their own, optionally
+// those whose primary category matches. Bundles are left out since their
+// stock is their components'.
+func (r *forecastRepository) Products(categoryID *uint) ([]models.Product, error) {
+	query := r.db.Model(&models.Product{}).
+		Where("NOT EXISTS (SELECT 1 FROM bundle_components bc WHERE bc.bundle_id = products.id)")
+
+	if categoryID != nil && *categoryID > 0 {
+		query = query.Where("category_id = ?", *categoryID)
+	}
+
+	var products []models.Product
+	if err := query.Order("id ASC").Find(&products).Error; err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+// DailyDemand returns, per product and day in [from, to), the stock used
+// up: the sum of the decreases in the product's stock from one history row
+// to the next, other than transfer dispatches. The first change in the window
+// is measured from the last row before it. Days run midnight to midnight in
+// location, and each is returned as its midnight there, whatever the
+// database session's time zone. Days without demand are left out.
+func (r *forecastRepository) DailyDemand(from, to time.Time, location *time.Location, categoryID *uint) ([]models.DailyDemand, error) {
+	var demand []models.DailyDemand
+	err := r.db.Table("(?) c", historyChanges(r.db, from, to, categoryID)).
+		Select("c.product_id, date_trunc('day', c.changed_at AT TIME ZONE @zone) AT TIME ZONE @zone AS day, SUM(c.decrease) AS quantity",
+			map[string]interface{}{"zone": location.String()}).
+		Where("c.changed_at >= ?", from).
+		Where(usedStock).
+		Group("c.product_id, day").
+		Order("c.product_id ASC, day ASC").
+		Scan(&demand).Error
+	if err != nil {
+		return nil, err
+	}
+	return demand, nil
+}
+
+// OnOrder returns, per product, the quantity still to be received on purchase
+// orders that have been sent to their supplier
+func (r *forecastRepository) OnOrder(categoryID *uint) (map[uint]models.Quantity, error) {
+	query := r.db.Table("purchase_order_lines l").
+		Select("l.product_id, SUM(l.quantity_ordered - l.quantity_received) AS quantity").
+		Joins("JOIN purchase_orders o ON o.id = l.purchase_order_id").
+		Where("o.status IN ?", []models.PurchaseOrderStatus{models.PurchaseOrderSent, models.PurchaseOrderPartiallyReceived}).
+		Where("l.quantity_ordered > l.quantity_received")
+
+	if categoryID != nil && *categoryID > 0 {
+		query = query.Joins("JOIN products p ON p.id = l.product_id").Where("p.category_id = ?", *categoryID)
+	}
+
+	var rows []struct {
+		ProductID uint
+		Quantity  models.Quantity
+	}
+	if err := query.Group("l.product_id").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+
+	onOrder := make(map[uint]models.Quantity, len(rows))
+	for _, row := range rows {
+		onOrder[row.ProductID] = row.Quantity
+	}
+	return onOrder, nil
+}
+
+// usedStock matches the history changes from historyChanges that used stock
+// up. Transfer dispatches move stock between warehouses rather than use it.
+var usedStock = clause.Expr{
+	SQL:  "c.decrease > 0 AND (c.type IS NULL OR c.type <> ?)",
+	Vars: []interface{}{models.MovementTransferOut},
+}
+
+// historyChanges selects the product history rows in [from, to), together
+// with each product's last row before from, optionally of products whose
+// primary category matches, with the change each row made: decrease is the
+// stock of the row before it less its own, and next_at when the next row
+// replaced it. Older rows are not read. The type of the movement behind the
+// row is included when there was one.
+func historyChanges(db *gorm.DB, from, to time.Time, categoryID *uint) *gorm.DB {
+	query := db.Table("product_history h").
+		Select("h.product_id, h.stock, h.changed_at, m.type, "+
+			"LAG(h.stock) OVER (PARTITION BY h.product_id ORDER BY h.changed_at, h.id) - h.stock AS decrease, "+
+			"LEAD(h.changed_at) OVER (PARTITION BY h.product_id ORDER BY h.changed_at, h.id) AS next_at").
+		Joins("JOIN products p ON p.id = h.product_id AND p.deleted_at IS NULL").
+		Joins("LEFT JOIN stock_movements m ON m.id = h.movement_id").
+		Where("h.changed_at < ?", to).
+		Where("h.changed_at >= COALESCE((SELECT MAX(b.changed_at) FROM product_history b "+
+			"WHERE b.product_id = h.product_id AND b.changed_at < @from), @from)", map[string]interface{}{"from": from})
+
+	if categoryID != nil && *categoryID > 0 {
+		query = query.Where("p.category_id = ?", *categoryID)
+	}
+	return query
+}
@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrLotRequired         = errors.New("lot number is required for lot-tracked products")
+	ErrLotQuantityMismatch = errors.New("lot quantities exceed the movement quantity")
+	ErrLotExpired          = errors.New("only expired lots are left to cover the movement")
+)
+
+type LotRepository interface {
+	ListByProduct(productID uint, warehouseID *uint, includeEmpty bool, page, pageSize int) ([]models.Lot, int64, error)
+	ListExpiring(before time.Time, warehouseID *uint, page, pageSize int) ([]models.Lot, int64, error)
+}
+
+type lotRepository struct {
+	db *gorm.DB
+}
+
+func NewLotRepository(db *gorm.DB) LotRepository {
+	return &lotRepository{db: db}
+}
+
+// ListByProduct returns a product's lots in picking (FEFO) order
+func (r *lotRepository) ListByProduct(productID uint, warehouseID *uint, includeEmpty bool, page, pageSize int) ([]models.Lot, int64, error) {
+	var lots []models.Lot
+	var total int64
+
+	query := r.db.Model(&models.Lot{}).Where("product_id = ?", productID)
+
+	if warehouseID != nil && *warehouseID > 0 {
+		query = query.Where("warehouse_id = ?", *warehouseID)
+	}
+	if !includeEmpty {
+		query = query.Where("quantity > 0")
+	}
+
+	query.Count(&total)
+
+	offset := (page - 1) * pageSize
+	err := query.Preload("Warehouse").Order("expires_at ASC NULLS LAST, id ASC").Offset(offset).Limit(pageSize).Find(&lots).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return lots, total, nil
+}
+
+// ListExpiring returns lots with stock that expire before the given time,
+// soonest first. Lots that have already expired are included.
+func (r *lotRepository) ListExpiring(before time.Time, warehouseID *uint, page, pageSize int) ([]models.Lot, int64, error) {
+	var lots []models.Lot
+	var total int64
+
+	query := r.db.Model(&models.Lot{}).Where("quantity > 0 AND expires_at IS NOT NULL AND expires_at <= ?", before)
+
+	if warehouseID != nil && *warehouseID > 0 {
+		query = query.Where("warehouse_id = ?", *warehouseID)
+	}
+
+	query.Count(&total)
+
+	offset := (page - 1) * pageSize
+	err := query.Preload("Product").Preload("Warehouse").Order("expires_at ASC, id ASC").Offset(offset).Limit(pageSize).Find(&lots).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return lots, total, nil
+}
+
+// applyLotMovement updates the lots of a lot-tracked product for a movement.
+// Inbound movements add to the lots named in movement.Lots, creating them as
+// needed; receipts must name at least one. Outbound movements consume lots
+// first-expired-first-out and record what they took in movement.Lots. Only
+// damage and adjustments, which write stock off or correct it, take expired
+// lots; other outbound movements skip them and fail with ErrLotExpired if
+// what is left is not enough. Stock from before the product was lot tracked
+// is consumed once its lots run out. It must run inside a transaction with
+// the product locked.
+func applyLotMovement(tx *gorm.DB, movement *models.StockMovement) error {
+	if movement.Quantity > 0 {
+		if movement.Type == models.MovementReceipt && len(movement.Lots) == 0 {
+			return ErrLotRequired
+		}
+
+		var total models.Quantity
+		for i := range movement.Lots {
+			entry := &movement.Lots[i]
+			if entry.Lot.LotNumber == "" {
+				return ErrLotRequired
+			}
+			lot, err := fillLot(tx, movement.ProductID, movement.WarehouseID, &entry.Lot, entry.Quantity)
+			if err != nil {
+				return err
+			}
+			entry.LotID = lot.ID
+			entry.Lot = *lot
+			total += entry.Quantity
+		}
+		if total > movement.Quantity {
+			return ErrLotQuantityMismatch
+		}
+		return nil
+	}
+
+	takesExpired := movement.Type == models.MovementDamage || movement.Type == models.MovementAdjustment
+	query := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("product_id = ? AND warehouse_id = ? AND quantity > 0", movement.ProductID, movement.WarehouseID)
+	if !takesExpired {
+		query = query.Where("expires_at IS NULL OR expires_at > ?", time.Now())
+	}
+
+	var lots []models.Lot
+	if err := query.Order("expires_at ASC NULLS LAST, id ASC").Find(&lots).Error; err != nil {
+		return err
+	}
+
+	movement.Lots = nil
+	remaining := -movement.Quantity
+	for _, lot := range lots {
+		if remaining == 0 {
+			break
+		}
+		take := lot.Quantity
+		if take > remaining {
+			take = remaining
+		}
+
+		err := tx.Model(&models.Lot{}).Where("id = ?", lot.ID).Updates(map[string]interface{}{
+			"quantity":   gorm.Expr("quantity - ?", take),
+			"updated_at": time.Now(),
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		lot.Quantity -= take
+		movement.Lots = append(movement.Lots, models.StockMovementLot{
+			LotID:    lot.ID,
+			Lot:      lot,
+			Quantity: -take,
+		})
+		remaining -= take
+	}
+
+	// The rest must come from stock outside any lot, not from the expired
+	// lots skipped above: with the warehouse quantity already reduced, that
+	// stock is what the warehouse holds beyond its lots
+	if remaining > 0 && !takesExpired {
+		quantity, err := locationQuantity(tx, movement.ProductID, movement.WarehouseID)
+		if err != nil {
+			return err
+		}
+		var lotStock models.Quantity
+		err = tx.Model(&models.Lot{}).Select("COALESCE(SUM(quantity), 0)").
+			Where("product_id = ? AND warehouse_id = ? AND quantity > 0", movement.ProductID, movement.WarehouseID).
+			Scan(&lotStock).Error
+		if err != nil {
+			return err
+		}
+		if quantity-lotStock < 0 {
+			return ErrLotExpired
+		}
+	}
+
+	return nil
+}
+
+// fillLot adds quantity to the product's lot with the given number at the
+// warehouse, creating the lot with the input's dates if it does not exist
+func fillLot(tx *gorm.DB, productID, warehouseID uint, input *models.Lot, quantity models.Quantity) (*models.Lot, error) {
+	var lot models.Lot
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("product_id = ? AND warehouse_id = ? AND lot_number = ?", productID, warehouseID, input.LotNumber).
+		First(&lot).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		lot = models.Lot{
+			ProductID:      productID,
+			WarehouseID:    warehouseID,
+			LotNumber:      input.LotNumber,
+			ManufacturedAt: input.ManufacturedAt,
+			ExpiresAt:      input.ExpiresAt,
+			Quantity:       quantity,
+		}
+		if err := tx.Omit(clause.Associations).Create(&lot).Error; err != nil {
+			return nil, err
+		}
+		return &lot, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	lot.Quantity += quantity
+	err = tx.Model(&lot).Updates(map[string]interface{}{
+		"quantity":   lot.Quantity,
+		"updated_at": time.Now(),
+	}).Error
+	if err != nil {
+		return nil, err
+	}
+	return &lot, nil
+}
@@ -0,0 +1,308 @@
+package repository
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrPriceChangeNotFound   = errors.New("scheduled price change not found")
+	ErrPriceChangeNotPending = errors.New("scheduled price change is not pending")
+	ErrPriceChangeOverlap    = errors.New("scheduled price change overlaps another one for the product")
+)
+
+type PriceChangeRepository interface {
+	Create(change *models.ScheduledPriceChange) error
+	FindByID(id uint) (*models.ScheduledPriceChange, error)
+	List(productID uint, status string, page, pageSize int) ([]models.ScheduledPriceChange, int64, error)
+	Cancel(id uint) (*models.ScheduledPriceChange, error)
+	ApplyDue(now time.Time) ([]models.ScheduledPriceChange, error)
+}
+
+type priceChangeRepository struct {
+	db *gorm.DB
+}
+
+func NewPriceChangeRepository(db *gorm.DB) PriceChangeRepository {
+	return &priceChangeRepository{db: db}
+}
+
+// Create schedules a price change, failing if it overlaps a pending or
+// active change for the same product
+func (r *priceChangeRepository) Create(change *models.ScheduledPriceChange) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockProduct(tx, change.ProductID); err != nil {
+			return err
+		}
+
+		var scheduled []models.ScheduledPriceChange
+		err := tx.Where("product_id = ? AND status IN ?", change.ProductID,
+			[]models.PriceChangeStatus{models.PriceChangePending, models.PriceChangeActive}).
+			Find(&scheduled).Error
+		if err != nil {
+			return err
+		}
+		for i := range scheduled {
+			if priceChangesOverlap(change, &scheduled[i]) {
+				return ErrPriceChangeOverlap
+			}
+		}
+
+		change.Status = models.PriceChangePending
+		return tx.Omit("Product").Create(change).Error
+	})
+}
+
+func (r *priceChangeRepository) FindByID(id uint) (*models.ScheduledPriceChange, error) {
+	var change models.ScheduledPriceChange
+	err := r.db.First(&change, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPriceChangeNotFound
+		}
+		return nil, err
+	}
+	return &change, nil
+}
+
+// List returns a product's price changes in the given status, or the
+// upcoming ones (pending or active) if no status is given, soonest first
+func (r *priceChangeRepository) List(productID uint, status string, page, pageSize int) ([]models.ScheduledPriceChange, int64, error) {
+	var changes []models.ScheduledPriceChange
+	var total int64
+
+	query := r.db.Model(&models.ScheduledPriceChange{}).Where("product_id = ?", productID)
+
+	if status != "" {
+		query = query.Where("status = ?", status)
+	} else {
+		query = query.Where("status IN ?", []models.PriceChangeStatus{models.PriceChangePending, models.PriceChangeActive})
+	}
+
+	query.Count(&total)
+
+	offset := (page - 1) * pageSize
+	err := query.Order("starts_at ASC, id ASC").Offset(offset).Limit(pageSize).Find(&changes).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return changes, total, nil
+}
+
+// Cancel cancels a price change that has not been applied yet
+func (r *priceChangeRepository) Cancel(id uint) (*models.ScheduledPriceChange, error) {
+	var change *models.ScheduledPriceChange
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		change, err = lockPriceChange(tx, id)
+		if err != nil {
+			return err
+		}
+		if change.Status != models.PriceChangePending {
+			return ErrPriceChangeNotPending
+		}
+
+		now := time.Now()
+		change.Status = models.PriceChangeCancelled
+		change.EndedAt = &now
+		return tx.Model(change).Updates(map[string]interface{}{
+			"status":     change.Status,
+			"ended_at":   change.EndedAt,
+			"updated_at": now,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return change, nil
+}
+
+// priceTransition is a due start or end of a scheduled price change
+type priceTransition struct {
+	id        uint
+	productID uint
+	at        time.Time
+	end       bool
+}
+
+// ApplyDue starts every pending price change whose start has passed and ends
+// every promotion whose end has passed, in the order they were due, and
+// returns the changes that changed a product's price. A promotion whose whole
+// window passed before it could be started expires without being applied.
+func (r *priceChangeRepository) ApplyDue(now time.Time) ([]models.ScheduledPriceChange, error) {
+	var candidates []models.ScheduledPriceChange
+	err := r.db.Model(&models.ScheduledPriceChange{}).
+		Joins("JOIN products p ON p.id = scheduled_price_changes.product_id AND p.deleted_at IS NULL").
+		Where("(scheduled_price_changes.status = ? AND scheduled_price_changes.starts_at <= ?) OR (scheduled_price_changes.status = ? AND scheduled_price_changes.ends_at <= ?)",
+			models.PriceChangePending, now, models.PriceChangeActive, now).
+		Find(&candidates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	transitions := make([]priceTransition, len(candidates))
+	for i, candidate := range candidates {
+		if candidate.Status == models.PriceChangeActive {
+			transitions[i] = priceTransition{id: candidate.ID, productID: candidate.ProductID, at: *candidate.EndsAt, end: true}
+		} else {
+			transitions[i] = priceTransition{id: candidate.ID, productID: candidate.ProductID, at: candidate.StartsAt}
+		}
+	}
+	sort.Slice(transitions, func(i, j int) bool {
+		if !transitions[i].at.Equal(transitions[j].at) {
+			return transitions[i].at.Before(transitions[j].at)
+		}
+		return transitions[i].id < transitions[j].id
+	})
+
+	var applied []models.ScheduledPriceChange
+	for _, transition := range transitions {
+		var change *models.ScheduledPriceChange
+		var priceChanged bool
+		err := r.db.Transaction(func(tx *gorm.DB) error {
+			// Lock the product first so price and stock changes share one lock order
+			product, err := lockProduct(tx, transition.productID)
+			if err != nil {
+				return err
+			}
+			change, err = lockPriceChange(tx, transition.id)
+			if err != nil {
+				return err
+			}
+			if transition.end {
+				priceChanged, err = endPriceChange(tx, product, change, now)
+			} else {
+				priceChanged, err = startPriceChange(tx, product, change, now)
+			}
+			return err
+		})
+		// The product may have been deleted meanwhile
+		if err != nil && !errors.Is(err, ErrProductNotFound) {
+			return applied, err
+		}
+		if err == nil && priceChanged {
+			applied = append(applied, *change)
+		}
+	}
+
+	return applied, nil
+}
+
+// startPriceChange sets the product's price from a pending change. It reports
+// whether the price changed; a change no longer pending is left alone.
+func startPriceChange(tx *gorm.DB, product *models.Product, change *models.ScheduledPriceChange, now time.Time) (bool, error) {
+	if change.Status != models.PriceChangePending {
+		return false, nil
+	}
+
+	updates := map[string]interface{}{"updated_at": now}
+	if change.IsPromotion() && !change.EndsAt.After(now) {
+		change.Status = models.PriceChangeExpired
+		change.EndedAt = &now
+		updates["status"] = change.Status
+		updates["ended_at"] = change.EndedAt
+		return false, tx.Model(change).Updates(updates).Error
+	}
+
+	previous := product.Price
+	change.PreviousPrice = &previous
+	change.AppliedAt = &now
+	change.Status = models.PriceChangeCompleted
+	if change.IsPromotion() {
+		change.Status = models.PriceChangeActive
+	}
+	updates["status"] = change.Status
+	updates["previous_price"] = change.PreviousPrice
+	updates["applied_at"] = change.AppliedAt
+	if err := tx.Model(change).Updates(updates).Error; err != nil {
+		return false, err
+	}
+
+	if change.Price == product.Price {
+		return false, nil
+	}
+	return true, setScheduledPrice(tx, product, change.Price, now)
+}
+
+// endPriceChange ends an active promotion, restoring the price it replaced
+// unless the price was changed again while it ran. It reports whether the
+// price changed.
+func endPriceChange(tx *gorm.DB, product *models.Product, change *models.ScheduledPriceChange, now time.Time) (bool, error) {
+	if change.Status != models.PriceChangeActive {
+		return false, nil
+	}
+
+	change.Status = models.PriceChangeCompleted
+	change.EndedAt = &now
+	err := tx.Model(change).Updates(map[string]interface{}{
+		"status":     change.Status,
+		"ended_at":   change.EndedAt,
+		"updated_at": now,
+	}).Error
+	if err != nil {
+		return false, err
+	}
+
+	if change.PreviousPrice == nil || product.Price != change.Price || product.Price == *change.PreviousPrice {
+		return false, nil
+	}
+	return true, setScheduledPrice(tx, product, *change.PreviousPrice, now)
+}
+
+// setScheduledPrice updates a locked product's price and records it in the
+// product's history
+func setScheduledPrice(tx *gorm.DB, product *models.Product, price models.Amount, now time.Time) error {
+	err := tx.Model(&models.Product{}).Where("id = ?", product.ID).Updates(map[string]interface{}{
+		"price":      price,
+		"updated_at": now,
+	}).Error
+	if err != nil {
+		return err
+	}
+	product.Price = price
+
+	history := &models.ProductHistory{
+		ProductID: product.ID,
+		Price:     product.Price,
+		Currency:  product.Currency,
+		Stock:     product.Stock,
+		ChangedAt: now,
+	}
+	return tx.Omit("Product").Create(history).Error
+}
+
+// lockPriceChange loads a scheduled price change with FOR UPDATE
+func lockPriceChange(tx *gorm.DB, id uint) (*models.ScheduledPriceChange, error) {
+	var change models.ScheduledPriceChange
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&change, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPriceChangeNotFound
+		}
+		return nil, err
+	}
+	return &change, nil
+}
+
+// priceChangesOverlap reports whether two price changes would be in effect
+// at the same time. A promotion covers its window up to its end; a
+// permanent change only its start.
+func priceChangesOverlap(a, b *models.ScheduledPriceChange) bool {
+	return priceChangeCovers(a, b.StartsAt) || priceChangeCovers(b, a.StartsAt)
+}
+
+func priceChangeCovers(change *models.ScheduledPriceChange, t time.Time) bool {
+	if t.Before(change.StartsAt) {
+		return false
+	}
+	if change.EndsAt == nil {
+		return t.Equal(change.StartsAt)
+	}
+	return t.Before(*change.EndsAt)
+}
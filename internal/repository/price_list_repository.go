@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrPriceListNotFound      = errors.New("price list not found")
+	ErrPriceListAlreadyExists = errors.New("price list with this code already exists")
+	ErrDuplicatePriceTier     = errors.New("a product may only have one price per minimum quantity")
+	ErrPriceListCurrency      = errors.New("products must be priced in the price list's currency")
+	ErrPriceListCurrencyFixed = errors.New("price list currency cannot change once it has prices")
+)
+
+type PriceListRepository interface {
+	Create(list *models.PriceList) error
+	FindByID(id uint) (*models.PriceList, error)
+	FindByCode(code string) (*models.PriceList, error)
+	Update(list *models.PriceList) error
+	Delete(id uint) error
+	List(page, pageSize int, search string) ([]models.PriceList, int64, error)
+	SetPrices(id uint, prices []models.PriceListPriceRequest) error
+	FindPrices(listID uint, productIDs []uint, quantity models.Quantity) (map[uint]models.PriceListPrice, error)
+}
+
+type priceListRepository struct {
+	db *gorm.DB
+}
+
+func NewPriceListRepository(db *gorm.DB) PriceListRepository {
+	return &priceListRepository{db: db}
+}
+
+func (r *priceListRepository) Create(list *models.PriceList) error {
+	// Check if a price list with the same code already exists
+	var count int64
+	r.db.Model(&models.PriceList{}).Where("code = ?", list.Code).Count(&count)
+	if count > 0 {
+		return ErrPriceListAlreadyExists
+	}
+
+	return r.db.Omit("Prices").Create(list).Error
+}
+
+// FindByID returns a price list with its prices, by product and then
+// minimum quantity
+func (r *priceListRepository) FindByID(id uint) (*models.PriceList, error) {
+	var list models.PriceList
+	err := r.db.Preload("Prices", func(db *gorm.DB) *gorm.DB {
+		return db.Order("product_id ASC, min_quantity ASC")
+	}).Preload("Prices.Product").First(&list, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPriceListNotFound
+		}
+		return nil, err
+	}
+	return &list, nil
+}
+
+// FindByCode returns a price list without its prices
+func (r *priceListRepository) FindByCode(code string) (*models.PriceList, error) {
+	var list models.PriceList
+	err := r.db.Where("code = ?", code).First(&list).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPriceListNotFound
+		}
+		return nil, err
+	}
+	return &list, nil
+}
+
+func (r *priceListRepository) Update(list *models.PriceList) error {
+	// Check if another price list with the same code exists
+	var count int64
+	r.db.Model(&models.PriceList{}).Where("code = ? AND id != ?", list.Code, list.ID).Count(&count)
+	if count > 0 {
+		return ErrPriceListAlreadyExists
+	}
+
+	// Prices are in the list's currency, so it is fixed once there are any
+	var currency string
+	if err := r.db.Model(&models.PriceList{}).Select("currency").Where("id = ?", list.ID).Scan(&currency).Error; err != nil {
+		return err
+	}
+	if currency != list.Currency {
+		var prices int64
+		r.db.Model(&models.PriceListPrice{}).Where("price_list_id = ?", list.ID).Count(&prices)
+		if prices > 0 {
+			return ErrPriceListCurrencyFixed
+		}
+	}
+
+	return r.db.Omit("Prices").Save(list).Error
+}
+
+// Delete removes a price list together with its prices
+func (r *priceListRepository) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Delete(&models.PriceList{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrPriceListNotFound
+		}
+		return tx.Where("price_list_id = ?", id).Delete(&models.PriceListPrice{}).Error
+	})
+}
+
+func (r *priceListRepository) List(page, pageSize int, search string) ([]models.PriceList, int64, error) {
+	var lists []models.PriceList
+	var total int64
+
+	query := r.db.Model(&models.PriceList{})
+
+	if search != "" {
+		searchPattern := "%" + search + "%"
+		query = query.Where("LOWER(code) LIKE LOWER(?) OR LOWER(name) LIKE LOWER(?)", searchPattern, searchPattern)
+	}
+
+	query.Count(&total)
+
+	offset := (page - 1) * pageSize
+	err := query.Offset(offset).Limit(pageSize).Order("id ASC").Find(&lists).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return lists, total, nil
+}
+
+// SetPrices replaces all prices on a price list. Each product may have one
+// price per minimum quantity and must be priced in the list's currency.
+func (r *priceListRepository) SetPrices(id uint, prices []models.PriceListPriceRequest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var list models.PriceList
+		if err := tx.First(&list, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrPriceListNotFound
+			}
+			return err
+		}
+
+		type tier struct {
+			productID   uint
+			minQuantity models.Quantity
+		}
+		seen := make(map[tier]bool, len(prices))
+		ids := make([]uint, len(prices))
+		rows := make([]models.PriceListPrice, len(prices))
+		for i, price := range prices {
+			minQuantity := price.MinQuantity
+			key := tier{productID: price.ProductID, minQuantity: minQuantity}
+			if seen[key] {
+				return ErrDuplicatePriceTier
+			}
+			seen[key] = true
+			ids[i] = price.ProductID
+			rows[i] = models.PriceListPrice{
+				PriceListID: id,
+				ProductID:   price.ProductID,
+				MinQuantity: minQuantity,
+				Price:       price.Price,
+			}
+		}
+		if err := verifyProductsExist(tx, ids); err != nil {
+			return err
+		}
+		if len(ids) > 0 {
+			var mismatched int64
+			err := tx.Model(&models.Product{}).Where("id IN ? AND currency <> ?", ids, list.Currency).Count(&mismatched).Error
+			if err != nil {
+				return err
+			}
+			if mismatched > 0 {
+				return ErrPriceListCurrency
+			}
+		}
+		sort.SliceStable(rows, func(i, j int) bool {
+			if rows[i].ProductID != rows[j].ProductID {
+				return rows[i].ProductID < rows[j].ProductID
+			}
+			return rows[i].MinQuantity < rows[j].MinQuantity
+		})
+
+		if err := tx.Where("price_list_id = ?", id).Delete(&models.PriceListPrice{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&list).UpdateColumn("updated_at", time.Now()).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.Omit("Product").Create(&rows).Error
+	})
+}
+
+// FindPrices returns, per product, the price on a list that applies to the
+// quantity: the one with the highest minimum quantity not above it.
+// Products without such a price are left out.
+func (r *priceListRepository) FindPrices(listID uint, productIDs []uint, quantity models.Quantity) (map[uint]models.PriceListPrice, error) {
+	prices := make(map[uint]models.PriceListPrice, len(productIDs))
+	if len(productIDs) == 0 {
+		return prices, nil
+	}
+
+	var rows []models.PriceListPrice
+	err := r.db.Where("price_list_id = ? AND product_id IN ? AND min_quantity <= ?", listID, productIDs, quantity).
+		Order("product_id ASC, min_quantity DESC").Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		if _, ok := prices[row.ProductID]; !ok {
+			prices[row.ProductID] = row
+		}
+	}
+	return prices, nil
+}
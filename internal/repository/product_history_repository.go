@@ -46,7 +46,7 @@ func (r *productHistoryRepository) FindByProductID(productID uint, start, end *t
 
 	// Apply pagination and ordering
 	offset := (page - 1) * pageSize
-	err := query.Order("changed_at DESC").Offset(offset).Limit(pageSize).Find(&history).Error
+	err := query.Preload("Movement.Lots.Lot").Preload("Movement.Serials.SerialNumber").Order("changed_at DESC, id DESC").Offset(offset).Limit(pageSize).Find(&history).Error
 	if err != nil {
 		return nil, 0, err
 	}
@@ -2,15 +2,22 @@ package repository
 
 import (
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/brunobarlari/inventorypulse/internal/domain/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 var (
-	ErrProductNotFound  = errors.New("product not found")
-	ErrProductSKUExists = errors.New("product with this SKU already exists")
-	ErrInvalidCategory  = errors.New("invalid category")
+	ErrProductNotFound    = errors.New("product not found")
+	ErrProductSKUExists   = errors.New("product with this SKU already exists")
+	ErrInvalidCategory    = errors.New("invalid category")
+	ErrInvalidTaxClass    = errors.New("invalid tax class")
+	ErrStockHeldElsewhere = errors.New("stock is held at other warehouses")
+	ErrProductHasVariants = errors.New("product has variants")
+	ErrCurrencyInUse      = errors.New("product currency is in use by its stock, movements, prices or open orders")
 )
 
 type ProductRepository interface {
@@ -20,8 +27,11 @@ type ProductRepository interface {
 	Update(product *models.Product, categoryIDs []uint) error
 	Delete(id uint) error
 	List(page, pageSize int, categoryID *uint, search string) ([]models.Product, int64, error)
-	UpdateStock(id uint, stock int) error
+	UpdateStock(id uint, stock models.Quantity, userID *uint) (*models.StockMovement, error)
+	GetStockLevels(id uint) ([]models.ProductStock, error)
+	UpdateStockAt(id, warehouseID uint, stock models.Quantity, userID *uint) (*models.StockMovement, error)
 	Search(query string, page, pageSize int) ([]models.Product, int64, error)
+	BaseQuantity(id uint, unit string, quantity models.Quantity) (models.Quantity, models.Quantity, error)
 }
 
 type productRepository struct {
@@ -58,44 +68,80 @@ func (r *productRepository) Create(product *models.Product, categoryIDs []uint)
 		}
 	}
 
-	// Create product
-	if err := r.db.Create(product).Error; err != nil {
-		return err
+	// Verify tax class exists if provided
+	if product.TaxClassID != nil {
+		var taxClassCount int64
+		r.db.Model(&models.TaxClass{}).Where("id = ?", *product.TaxClassID).Count(&taxClassCount)
+		if taxClassCount == 0 {
+			return ErrInvalidTaxClass
+		}
 	}
 
-	// Associate categories
-	if len(categoryIDs) > 0 {
-		var categories []models.Category
-		r.db.Where("id IN ?", categoryIDs).Find(&categories)
-		if err := r.db.Model(product).Association("Categories").Replace(categories); err != nil {
+	// Initial stock is recorded as a movement once the product exists
+	initialStock := product.Stock
+	product.Stock = 0
+
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Create product
+		if err := tx.Omit("Stocks").Create(product).Error; err != nil {
 			return err
 		}
+
+		// Associate categories
+		if len(categoryIDs) > 0 {
+			var categories []models.Category
+			tx.Where("id IN ?", categoryIDs).Find(&categories)
+			if err := tx.Model(product).Association("Categories").Replace(categories); err != nil {
+				return err
+			}
+		}
+
+		if initialStock != 0 {
+			return applyStockMovement(tx, &models.StockMovement{
+				ProductID: product.ID,
+				Type:      models.MovementAdjustment,
+				Quantity:  initialStock,
+				Reason:    "Initial stock",
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
+	product.Stock = initialStock
 	return nil
 }
 
 func (r *productRepository) FindByID(id uint) (*models.Product, error) {
 	var product models.Product
-	err := r.db.Preload("Category").Preload("Categories").First(&product, id).Error
+	err := preloadUnits(preloadComponents(preloadVariantOptions(r.db))).Preload("Category").Preload("Categories").Preload("Stocks.Warehouse").First(&product, id).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrProductNotFound
 		}
 		return nil, err
 	}
+	if err := loadVariantTotals(r.db, &product); err != nil {
+		return nil, err
+	}
 	return &product, nil
 }
 
 func (r *productRepository) FindBySKU(sku string) (*models.Product, error) {
 	var product models.Product
-	err := r.db.Preload("Category").Preload("Categories").Where("sku = ?", sku).First(&product).Error
+	err := preloadUnits(preloadComponents(preloadVariantOptions(r.db))).Preload("Category").Preload("Categories").Preload("Stocks.Warehouse").Where("sku = ?", sku).First(&product).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, ErrProductNotFound
 		}
 		return nil, err
 	}
+	if err := loadVariantTotals(r.db, &product); err != nil {
+		return nil, err
+	}
 	return &product, nil
 }
 
@@ -125,8 +171,53 @@ func (r *productRepository) Update(product *models.Product, categoryIDs []uint)
 		}
 	}
 
-	// Update product
-	if err := r.db.Save(product).Error; err != nil {
+	// Verify tax class exists if provided
+	if product.TaxClassID != nil {
+		var taxClassCount int64
+		r.db.Model(&models.TaxClass{}).Where("id = ?", *product.TaxClassID).Count(&taxClassCount)
+		if taxClassCount == 0 {
+			return ErrInvalidTaxClass
+		}
+	}
+
+	// The base unit cannot share its name with an alternative unit
+	var clash int64
+	r.db.Model(&models.ProductUnit{}).Where("product_id = ? AND name = ?", product.ID, product.BaseUnit).Count(&clash)
+	if clash > 0 {
+		return ErrDuplicateUnit
+	}
+
+	// Costs, scheduled prices and open order lines are kept in the product's
+	// currency, so it cannot change under them
+	var currency string
+	if err := r.db.Model(&models.Product{}).Select("currency").Where("id = ?", product.ID).Scan(&currency).Error; err != nil {
+		return err
+	}
+	if currency != "" && currency != product.Currency {
+		inUse, err := currencyInUse(r.db, product.ID)
+		if err != nil {
+			return err
+		}
+		if inUse {
+			return ErrCurrencyInUse
+		}
+	}
+
+	// Quantities already held must fit a narrowed quantity precision
+	if !product.ValidQuantity(product.Stock) || !product.ValidQuantity(product.Reserved) {
+		return ErrQuantityPrecision
+	}
+	for _, stock := range product.Stocks {
+		if !product.ValidQuantity(stock.Quantity) {
+			return ErrQuantityPrecision
+		}
+	}
+
+	// Update product; stock and reservations are only changed through their
+	// own methods, average cost through movements, variant options through
+	// variant generation, bundle components through their bill of materials
+	// and alternative units through their own method
+	if err := r.db.Omit("Stock", "Reserved", "AverageCost", "Stocks", "ParentID", "Options", "OptionValues", "Components", "Units").Save(product).Error; err != nil {
 		return err
 	}
 
@@ -152,6 +243,20 @@ func (r *productRepository) Delete(id uint) error {
 		return err
 	}
 
+	// A parent cannot go while its variants remain
+	var variants int64
+	r.db.Model(&models.Product{}).Where("parent_id = ?", id).Count(&variants)
+	if variants > 0 {
+		return ErrProductHasVariants
+	}
+
+	// Bundles still selling the product need it
+	var bundles int64
+	r.db.Model(&models.BundleComponent{}).Where("component_id = ?", id).Count(&bundles)
+	if bundles > 0 {
+		return ErrProductInBundle
+	}
+
 	// Clear associations
 	r.db.Model(&product).Association("Categories").Clear()
 
@@ -183,20 +288,81 @@ func (r *productRepository) List(page, pageSize int, categoryID *uint, search st
 	query.Count(&total)
 
 	offset := (page - 1) * pageSize
-	err := query.Preload("Category").Preload("Categories").Offset(offset).Limit(pageSize).Order("id ASC").Find(&products).Error
+	err := preloadUnits(preloadComponents(preloadVariantOptions(query))).Preload("Category").Preload("Categories").Offset(offset).Limit(pageSize).Order("id ASC").Find(&products).Error
 	if err != nil {
 		return nil, 0, err
 	}
+	if err := loadVariantTotals(r.db, productRefs(products)...); err != nil {
+		return nil, 0, err
+	}
 
 	return products, total, nil
 }
 
-func (r *productRepository) UpdateStock(id uint, stock int) error {
-	result := r.db.Model(&models.Product{}).Where("id = ?", id).Update("stock", stock)
-	if result.RowsAffected == 0 {
-		return ErrProductNotFound
+// UpdateStock sets the aggregate stock of a product by recording an
+// adjustment of the difference at the default warehouse
+func (r *productRepository) UpdateStock(id uint, stock models.Quantity, userID *uint) (*models.StockMovement, error) {
+	var movement *models.StockMovement
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		product, err := lockProduct(tx, id)
+		if err != nil {
+			return err
+		}
+
+		warehouse, err := findDefaultWarehouse(tx)
+		if err != nil {
+			return err
+		}
+
+		current, err := locationQuantity(tx, id, warehouse.ID)
+		if err != nil {
+			return err
+		}
+
+		quantity := current + stock - product.Stock
+		if quantity < 0 {
+			return ErrStockHeldElsewhere
+		}
+
+		movement, err = setLocationStock(tx, id, warehouse.ID, quantity, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
 	}
-	return result.Error
+	return movement, nil
+}
+
+func (r *productRepository) GetStockLevels(id uint) ([]models.ProductStock, error) {
+	var stocks []models.ProductStock
+	err := r.db.Preload("Warehouse").Where("product_id = ?", id).Order("warehouse_id ASC").Find(&stocks).Error
+	if err != nil {
+		return nil, err
+	}
+	return stocks, nil
+}
+
+func (r *productRepository) UpdateStockAt(id, warehouseID uint, stock models.Quantity, userID *uint) (*models.StockMovement, error) {
+	var movement *models.StockMovement
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if _, err := lockProduct(tx, id); err != nil {
+			return err
+		}
+
+		var count int64
+		tx.Model(&models.Warehouse{}).Where("id = ?", warehouseID).Count(&count)
+		if count == 0 {
+			return ErrWarehouseNotFound
+		}
+
+		var err error
+		movement, err = setLocationStock(tx, id, warehouseID, stock, userID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return movement, nil
 }
 
 func (r *productRepository) Search(query string, page, pageSize int) ([]models.Product, int64, error) {
@@ -212,10 +378,166 @@ func (r *productRepository) Search(query string, page, pageSize int) ([]models.P
 	dbQuery.Count(&total)
 
 	offset := (page - 1) * pageSize
-	err := dbQuery.Preload("Category").Preload("Categories").Offset(offset).Limit(pageSize).Order("id ASC").Find(&products).Error
+	err := preloadUnits(preloadComponents(preloadVariantOptions(dbQuery))).Preload("Category").Preload("Categories").Offset(offset).Limit(pageSize).Order("id ASC").Find(&products).Error
 	if err != nil {
 		return nil, 0, err
 	}
+	if err := loadVariantTotals(r.db, productRefs(products)...); err != nil {
+		return nil, 0, err
+	}
 
 	return products, total, nil
 }
+
+// BaseQuantity converts a quantity in one of a product's units into its
+// base unit, returning it with the number of base units in the unit. An
+// empty unit is the base unit. The converted quantity must fit the
+// product's quantity precision.
+func (r *productRepository) BaseQuantity(id uint, unit string, quantity models.Quantity) (models.Quantity, models.Quantity, error) {
+	var product models.Product
+	err := preloadUnits(r.db).Select("id", "base_unit", "quantity_precision").First(&product, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, 0, ErrProductNotFound
+		}
+		return 0, 0, err
+	}
+
+	factor, ok := product.UnitFactor(unit)
+	if !ok {
+		return 0, 0, ErrUnknownUnit
+	}
+	base := quantity.Mul(factor)
+	if !product.ValidQuantity(base) {
+		return 0, 0, ErrQuantityPrecision
+	}
+	return base, factor, nil
+}
+
+// lockProduct loads a product row with FOR UPDATE so concurrent stock
+// changes to the same product are serialized
+func lockProduct(tx *gorm.DB, id uint) (*models.Product, error) {
+	var product models.Product
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&product, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrProductNotFound
+		}
+		return nil, err
+	}
+	return &product, nil
+}
+
+// currencyInUse reports whether anything is valued in the product's
+// currency: stock or movements, which carry its costs, pending or active
+// scheduled prices, price list prices, or lines of open purchase, sales or
+// work orders
+func currencyInUse(tx *gorm.DB, productID uint) (bool, error) {
+	checks := []*gorm.DB{
+		tx.Model(&models.ProductStock{}).Where("product_id = ? AND quantity <> 0", productID),
+		tx.Model(&models.StockMovement{}).Where("product_id = ?", productID),
+		tx.Model(&models.ScheduledPriceChange{}).
+			Where("product_id = ? AND status IN ?", productID, []models.PriceChangeStatus{models.PriceChangePending, models.PriceChangeActive}),
+		tx.Model(&models.PriceListPrice{}).
+			Joins("JOIN price_lists l ON l.id = price_list_prices.price_list_id AND l.deleted_at IS NULL").
+			Where("price_list_prices.product_id = ?", productID),
+		tx.Model(&models.PurchaseOrderLine{}).
+			Joins("JOIN purchase_orders o ON o.id = purchase_order_lines.purchase_order_id").
+			Where("purchase_order_lines.product_id = ? AND o.status IN ?", productID, []models.PurchaseOrderStatus{
+				models.PurchaseOrderDraft, models.PurchaseOrderSent, models.PurchaseOrderPartiallyReceived,
+			}),
+		tx.Model(&models.SalesOrderLine{}).
+			Joins("JOIN sales_orders o ON o.id = sales_order_lines.sales_order_id").
+			Where("sales_order_lines.product_id = ? AND o.status IN ?", productID, []models.SalesOrderStatus{
+				models.SalesOrderPending, models.SalesOrderAllocated, models.SalesOrderPicked,
+			}),
+		tx.Model(&models.WorkOrder{}).
+			Where("status IN ?", []models.WorkOrderStatus{models.WorkOrderDraft, models.WorkOrderInProgress}).
+			Where("product_id = ? OR id IN (?)", productID,
+				tx.Model(&models.WorkOrderComponent{}).Select("work_order_id").Where("product_id = ?", productID)),
+	}
+	for _, query := range checks {
+		var count int64
+		if err := query.Limit(1).Count(&count).Error; err != nil {
+			return false, err
+		}
+		if count > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// locationQuantity returns the quantity of a product held at a warehouse
+func locationQuantity(tx *gorm.DB, productID, warehouseID uint) (models.Quantity, error) {
+	var quantity models.Quantity
+	err := tx.Model(&models.ProductStock{}).Select("COALESCE(SUM(quantity), 0)").
+		Where("product_id = ? AND warehouse_id = ?", productID, warehouseID).
+		Scan(&quantity).Error
+	return quantity, err
+}
+
+// setLocationStock records the adjustment movement that brings the quantity
+// at a warehouse to the given value. It returns nil if nothing changed.
+// Bundles have no quantity of their own to set.
+func setLocationStock(tx *gorm.DB, productID, warehouseID uint, quantity models.Quantity, userID *uint) (*models.StockMovement, error) {
+	var components int64
+	tx.Model(&models.BundleComponent{}).Where("bundle_id = ?", productID).Count(&components)
+	if components > 0 {
+		return nil, ErrBundleAbsoluteStock
+	}
+
+	current, err := locationQuantity(tx, productID, warehouseID)
+	if err != nil {
+		return nil, err
+	}
+	if current == quantity {
+		return nil, nil
+	}
+
+	movement := &models.StockMovement{
+		ProductID:   productID,
+		WarehouseID: warehouseID,
+		Type:        models.MovementAdjustment,
+		Quantity:    quantity - current,
+		Reason:      fmt.Sprintf("Stock set from %s to %s", current, quantity),
+		UserID:      userID,
+	}
+	if err := applyStockMovement(tx, movement); err != nil {
+		return nil, err
+	}
+	return movement, nil
+}
+
+// syncProductStock recomputes products.stock as the sum of its locations
+func syncProductStock(tx *gorm.DB, productID uint) error {
+	return tx.Model(&models.Product{}).Where("id = ?", productID).Updates(map[string]interface{}{
+		"stock":      gorm.Expr("(SELECT COALESCE(SUM(quantity), 0) FROM product_stocks WHERE product_id = ?)", productID),
+		"updated_at": time.Now(),
+	}).Error
+}
+
+// verifyProductsExist returns ErrProductNotFound unless every ID refers to a product
+func verifyProductsExist(db *gorm.DB, ids []uint) error {
+	unique := make(map[uint]bool, len(ids))
+	for _, id := range ids {
+		unique[id] = true
+	}
+	if len(unique) == 0 {
+		return nil
+	}
+
+	distinct := make([]uint, 0, len(unique))
+	for id := range unique {
+		distinct = append(distinct, id)
+	}
+
+	var count int64
+	if err := db.Model(&models.Product{}).Where("id IN ?", distinct).Count(&count).Error; err != nil {
+		return err
+	}
+	if int(count) != len(distinct) {
+		return ErrProductNotFound
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrUnknownUnit   = errors.New("unit is not defined for the product")
+	ErrDuplicateUnit = errors.New("unit names must be unique and differ from the base unit")
+	ErrUnitFactor    = errors.New("unit factor must be at least 0.001 with at most 3 decimal places")
+)
+
+type ProductUnitRepository interface {
+	SetUnits(productID uint, baseUnit string, units []models.ProductUnitRequest) error
+}
+
+type productUnitRepository struct {
+	db *gorm.DB
+}
+
+func NewProductUnitRepository(db *gorm.DB) ProductUnitRepository {
+	return &productUnitRepository{db: db}
+}
+
+// SetUnits replaces a product's alternative units and renames its base unit
+// if baseUnit is not empty. Quantities are stored in the base unit, so
+// neither changes existing stock.
+func (r *productUnitRepository) SetUnits(productID uint, baseUnit string, units []models.ProductUnitRequest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		product, err := lockProduct(tx, productID)
+		if err != nil {
+			return err
+		}
+		if baseUnit == "" {
+			baseUnit = product.BaseUnit
+		}
+
+		seen := map[string]bool{baseUnit: true}
+		rows := make([]models.ProductUnit, len(units))
+		for i, unit := range units {
+			if seen[unit.Name] {
+				return ErrDuplicateUnit
+			}
+			seen[unit.Name] = true
+			if !models.ValidUnitFactor(unit.Factor) {
+				return ErrUnitFactor
+			}
+			rows[i] = models.ProductUnit{
+				ProductID: productID,
+				Name:      unit.Name,
+				Factor:    unit.Factor,
+			}
+		}
+		sort.SliceStable(rows, func(i, j int) bool { return rows[i].Factor < rows[j].Factor })
+
+		if baseUnit != product.BaseUnit {
+			if err := tx.Model(product).UpdateColumn("base_unit", baseUnit).Error; err != nil {
+				return err
+			}
+		}
+
+		if err := tx.Where("product_id = ?", productID).Delete(&models.ProductUnit{}).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.Create(&rows).Error
+	})
+}
+
+// preloadUnits adds a product's alternative units, smallest first, to a
+// product query
+func preloadUnits(db *gorm.DB) *gorm.DB {
+	return db.Preload("Units", func(db *gorm.DB) *gorm.DB {
+		return db.Order("factor ASC, id ASC")
+	})
+}
@@ -0,0 +1,378 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrVariantParent          = errors.New("variants cannot have variants of their own")
+	ErrVariantOptionsMismatch = errors.New("option axes do not match the product's existing axes")
+	ErrVariantSKUTooLong      = errors.New("generated variant SKU is too long")
+	ErrTooManyVariants        = fmt.Errorf("option values would make more than %d variants", maxVariants)
+)
+
+const (
+	// maxSKULength matches the size of the products.sku column
+	maxSKULength = 50
+
+	// maxVariants caps a parent's variant matrix, so one request cannot
+	// create an unbounded number of products
+	maxVariants = 500
+)
+
+type ProductVariantRepository interface {
+	ListVariants(parentID uint) ([]models.Product, error)
+	Generate(parentID uint, options []models.VariantOptionRequest, price *models.Amount) ([]models.Product, error)
+}
+
+type productVariantRepository struct {
+	db *gorm.DB
+}
+
+func NewProductVariantRepository(db *gorm.DB) ProductVariantRepository {
+	return &productVariantRepository{db: db}
+}
+
+// ListVariants returns a parent product's variants in creation order
+func (r *productVariantRepository) ListVariants(parentID uint) ([]models.Product, error) {
+	var variants []models.Product
+	err := preloadUnits(preloadComponents(preloadVariantOptions(r.db))).Preload("Category").Preload("Categories").Preload("Stocks.Warehouse").
+		Where("parent_id = ?", parentID).Order("id ASC").Find(&variants).Error
+	if err != nil {
+		return nil, err
+	}
+	return variants, nil
+}
+
+// Generate merges the option values into the parent's axes and creates a
+// variant for every combination of axis values that does not have one yet,
+// restoring combinations whose variant was deleted. Variants copy the
+// parent's details, categories, units and tracking settings, and get the
+// parent's SKU suffixed with their values. The matrix is capped at
+// maxVariants combinations. It returns the variants it created or restored.
+func (r *productVariantRepository) Generate(parentID uint, options []models.VariantOptionRequest, price *models.Amount) ([]models.Product, error) {
+	var created []models.Product
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		parent, err := lockProduct(tx, parentID)
+		if err != nil {
+			return err
+		}
+		if parent.ParentID != nil {
+			return ErrVariantParent
+		}
+
+		axes, err := mergeOptionAxes(tx, parentID, options)
+		if err != nil {
+			return err
+		}
+		if combinationCount(axes) > maxVariants {
+			return ErrTooManyVariants
+		}
+
+		// Deleted variants still hold their SKU, so they are restored rather
+		// than created again
+		var existing []models.Product
+		if err := tx.Unscoped().Preload("OptionValues").Where("parent_id = ?", parentID).Find(&existing).Error; err != nil {
+			return err
+		}
+		taken := make(map[string]*models.Product, len(existing))
+		for i := range existing {
+			taken[combinationKey(existing[i].OptionValues)] = &existing[i]
+		}
+
+		var categories []models.Category
+		if err := tx.Model(parent).Association("Categories").Find(&categories); err != nil {
+			return err
+		}
+		var units []models.ProductUnit
+		if err := tx.Where("product_id = ?", parentID).Order("id ASC").Find(&units).Error; err != nil {
+			return err
+		}
+
+		variantPrice := parent.Price
+		if price != nil {
+			variantPrice = *price
+		}
+
+		for _, combination := range combinations(axes) {
+			if variant, ok := taken[combinationKey(combination)]; ok {
+				if variant.DeletedAt.Valid {
+					if err := tx.Unscoped().Model(variant).Update("deleted_at", nil).Error; err != nil {
+						return err
+					}
+					variant.DeletedAt = gorm.DeletedAt{}
+					// Deleting a product cleared its categories
+					if len(categories) > 0 {
+						if err := tx.Model(variant).Association("Categories").Replace(categories); err != nil {
+							return err
+						}
+					}
+					created = append(created, *variant)
+				}
+				continue
+			}
+
+			names := make([]string, len(combination))
+			codes := make([]string, len(combination))
+			for i, value := range combination {
+				names[i] = value.Value
+				codes[i] = skuCode(value.Value)
+			}
+
+			variant := models.Product{
+				ParentID:          &parent.ID,
+				Name:              parent.Name + " - " + strings.Join(names, " / "),
+				Description:       parent.Description,
+				SKU:               parent.SKU + "-" + strings.Join(codes, "-"),
+				Price:             variantPrice,
+				Currency:          parent.Currency,
+				CategoryID:        parent.CategoryID,
+				LotTracked:        parent.LotTracked,
+				Serialized:        parent.Serialized,
+				BaseUnit:          parent.BaseUnit,
+				QuantityPrecision: parent.QuantityPrecision,
+				TaxClassID:        parent.TaxClassID,
+			}
+			if len(variant.SKU) > maxSKULength {
+				return ErrVariantSKUTooLong
+			}
+
+			// Soft-deleted products still hold their SKU in the unique index
+			var count int64
+			tx.Unscoped().Model(&models.Product{}).Where("sku = ?", variant.SKU).Count(&count)
+			if count > 0 {
+				return ErrProductSKUExists
+			}
+
+			if err := tx.Omit(clause.Associations).Create(&variant).Error; err != nil {
+				return err
+			}
+			links := make([]map[string]interface{}, len(combination))
+			for i, value := range combination {
+				links[i] = map[string]interface{}{"product_id": variant.ID, "option_value_id": value.ID}
+			}
+			if err := tx.Table("product_variant_values").Create(&links).Error; err != nil {
+				return err
+			}
+			if len(categories) > 0 {
+				if err := tx.Model(&variant).Association("Categories").Replace(categories); err != nil {
+					return err
+				}
+			}
+			if len(units) > 0 {
+				copies := make([]models.ProductUnit, len(units))
+				for i, unit := range units {
+					copies[i] = models.ProductUnit{ProductID: variant.ID, Name: unit.Name, Factor: unit.Factor}
+				}
+				if err := tx.Create(&copies).Error; err != nil {
+					return err
+				}
+			}
+
+			created = append(created, variant)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// mergeOptionAxes defines the parent's option axes on first use, or checks
+// that the request names the existing ones, and adds any new values. It
+// returns the axes in position order with all their values.
+func mergeOptionAxes(tx *gorm.DB, parentID uint, options []models.VariantOptionRequest) ([]models.ProductOption, error) {
+	var axes []models.ProductOption
+	err := tx.Preload("Values", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position ASC")
+	}).Where("product_id = ?", parentID).Order("position ASC").Find(&axes).Error
+	if err != nil {
+		return nil, err
+	}
+
+	requested := make(map[string]models.VariantOptionRequest, len(options))
+	for _, option := range options {
+		name := strings.TrimSpace(option.Name)
+		if _, dup := requested[name]; dup || name == "" {
+			return nil, ErrVariantOptionsMismatch
+		}
+		requested[name] = option
+	}
+
+	if len(axes) == 0 {
+		for i, option := range options {
+			axes = append(axes, models.ProductOption{
+				ProductID: parentID,
+				Name:      strings.TrimSpace(option.Name),
+				Position:  i,
+			})
+		}
+		if err := tx.Omit("Values").Create(&axes).Error; err != nil {
+			return nil, err
+		}
+	} else if len(axes) != len(requested) {
+		return nil, ErrVariantOptionsMismatch
+	}
+
+	for i := range axes {
+		axis := &axes[i]
+		option, ok := requested[axis.Name]
+		if !ok {
+			return nil, ErrVariantOptionsMismatch
+		}
+
+		known := make(map[string]bool, len(axis.Values))
+		for _, value := range axis.Values {
+			known[value.Value] = true
+		}
+		for _, raw := range option.Values {
+			value := strings.TrimSpace(raw)
+			if value == "" || known[value] {
+				continue
+			}
+			known[value] = true
+
+			entry := models.ProductOptionValue{
+				OptionID: axis.ID,
+				Value:    value,
+				Position: len(axis.Values),
+			}
+			if err := tx.Omit("Option").Create(&entry).Error; err != nil {
+				return nil, err
+			}
+			axis.Values = append(axis.Values, entry)
+		}
+	}
+
+	return axes, nil
+}
+
+// combinations returns every combination of one value per axis, in axis
+// and value position order
+func combinations(axes []models.ProductOption) [][]models.ProductOptionValue {
+	result := [][]models.ProductOptionValue{{}}
+	for _, axis := range axes {
+		var next [][]models.ProductOptionValue
+		for _, prefix := range result {
+			for _, value := range axis.Values {
+				combination := make([]models.ProductOptionValue, len(prefix), len(prefix)+1)
+				copy(combination, prefix)
+				next = append(next, append(combination, value))
+			}
+		}
+		result = next
+	}
+	return result
+}
+
+// combinationCount returns how many combinations the axes make, stopping
+// once it passes maxVariants
+func combinationCount(axes []models.ProductOption) int {
+	count := 1
+	for _, axis := range axes {
+		count *= len(axis.Values)
+		if count > maxVariants {
+			return count
+		}
+	}
+	return count
+}
+
+// combinationKey identifies a set of option values regardless of order
+func combinationKey(values []models.ProductOptionValue) string {
+	ids := make([]int, len(values))
+	for i, value := range values {
+		ids[i] = int(value.ID)
+	}
+	sort.Ints(ids)
+
+	var b strings.Builder
+	for _, id := range ids {
+		b.WriteString(strconv.Itoa(id))
+		b.WriteByte(',')
+	}
+	return b.String()
+}
+
+// skuCode turns an option value into an SKU segment: letters and digits
+// only, upper-cased
+func skuCode(value string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(value) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// preloadVariantOptions adds a parent's option axes and a variant's option
+// values to a product query
+func preloadVariantOptions(db *gorm.DB) *gorm.DB {
+	return db.Preload("Options", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position ASC")
+	}).Preload("Options.Values", func(db *gorm.DB) *gorm.DB {
+		return db.Order("position ASC")
+	}).Preload("OptionValues.Option")
+}
+
+// loadVariantTotals fills the variant count, stock and available totals of
+// parent products
+func loadVariantTotals(db *gorm.DB, products ...*models.Product) error {
+	if len(products) == 0 {
+		return nil
+	}
+
+	ids := make([]uint, len(products))
+	for i, product := range products {
+		ids[i] = product.ID
+	}
+
+	var totals []struct {
+		ParentID  uint
+		Count     int
+		Stock     models.Quantity
+		Available models.Quantity
+	}
+	err := db.Model(&models.Product{}).
+		Select("parent_id, COUNT(*) AS count, COALESCE(SUM(stock), 0) AS stock, COALESCE(SUM(GREATEST(stock - reserved, 0)), 0) AS available").
+		Where("parent_id IN ?", ids).Group("parent_id").Scan(&totals).Error
+	if err != nil {
+		return err
+	}
+
+	byParent := make(map[uint]int, len(totals))
+	for i, total := range totals {
+		byParent[total.ParentID] = i
+	}
+	for _, product := range products {
+		i, ok := byParent[product.ID]
+		if !ok {
+			continue
+		}
+		product.VariantCount = totals[i].Count
+		product.VariantStock = totals[i].Stock
+		product.VariantAvailable = totals[i].Available
+	}
+	return nil
+}
+
+// productRefs returns pointers to the products in a slice
+func productRefs(products []models.Product) []*models.Product {
+	refs := make([]*models.Product, len(products))
+	for i := range products {
+		refs[i] = &products[i]
+	}
+	return refs
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+)
+
+// axis builds an option whose values have the given IDs
+func axis(name string, ids ...uint) models.ProductOption {
+	option := models.ProductOption{Name: name}
+	for _, id := range ids {
+		option.Values = append(option.Values, models.ProductOptionValue{ID: id})
+	}
+	return option
+}
+
+func TestCombinations(t *testing.T) {
+	tests := []struct {
+		name string
+		axes []models.ProductOption
+		want []string
+	}{
+		{"no axes", nil, []string{""}},
+		{"one axis", []models.ProductOption{axis("Size", 1, 2, 3)}, []string{"1,", "2,", "3,"}},
+		{
+			name: "two axes in axis then value order",
+			axes: []models.ProductOption{axis("Size", 1, 2), axis("Color", 3, 4)},
+			want: []string{"1,3,", "1,4,", "2,3,", "2,4,"},
+		},
+		{
+			name: "three axes",
+			axes: []models.ProductOption{axis("Size", 1, 2), axis("Color", 3), axis("Fit", 4, 5)},
+			want: []string{"1,3,4,", "1,3,5,", "2,3,4,", "2,3,5,"},
+		},
+		{"axis without values", []models.ProductOption{axis("Size", 1, 2), axis("Color")}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, combination := range combinations(tt.axes) {
+				if len(combination) != len(tt.axes) {
+					t.Fatalf("combinations() gave %d values, want %d", len(combination), len(tt.axes))
+				}
+				got = append(got, combinationKey(combination))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("combinations() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCombinationCount(t *testing.T) {
+	many := make([]uint, 30)
+	for i := range many {
+		many[i] = uint(i + 1)
+	}
+
+	tests := []struct {
+		name string
+		axes []models.ProductOption
+		want int
+	}{
+		{"no axes", nil, 1},
+		{"two axes", []models.ProductOption{axis("Size", 1, 2, 3), axis("Color", 4, 5)}, 6},
+		{"axis without values", []models.ProductOption{axis("Size", 1, 2), axis("Color")}, 0},
+		{"at the cap", []models.ProductOption{axis("A", many[:25]...), axis("B", many[:20]...)}, maxVariants},
+		{"stops past the cap", []models.ProductOption{axis("A", many...), axis("B", many...), axis("C", many...)}, 900},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := combinationCount(tt.axes); got != tt.want {
+				t.Errorf("combinationCount() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
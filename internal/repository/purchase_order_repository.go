@@ -0,0 +1,378 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrPurchaseOrderNotFound     = errors.New("purchase order not found")
+	ErrPurchaseOrderStatus       = errors.New("purchase order status does not allow this action")
+	ErrPurchaseOrderLineNotFound = errors.New("purchase order line not found")
+	ErrOverReceipt               = errors.New("received quantity exceeds the outstanding quantity")
+	ErrCostCurrency              = errors.New("line products must be costed in the order's currency")
+)
+
+type PurchaseOrderRepository interface {
+	Create(order *models.PurchaseOrder) error
+	FindByID(id uint) (*models.PurchaseOrder, error)
+	Update(order *models.PurchaseOrder, lines []models.PurchaseOrderLine) error
+	Delete(id uint) error
+	List(supplierID *uint, status string, page, pageSize int) ([]models.PurchaseOrder, int64, error)
+	Send(id uint) (*models.PurchaseOrder, error)
+	Receive(id uint, receipts []models.ReceiveLineRequest, userID *uint) (*models.PurchaseOrder, []models.StockMovement, error)
+	Close(id uint) (*models.PurchaseOrder, error)
+}
+
+type purchaseOrderRepository struct {
+	db *gorm.DB
+}
+
+func NewPurchaseOrderRepository(db *gorm.DB) PurchaseOrderRepository {
+	return &purchaseOrderRepository{db: db}
+}
+
+// Create inserts a draft purchase order with its lines and assigns its number
+func (r *purchaseOrderRepository) Create(order *models.PurchaseOrder) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := validatePurchaseOrder(tx, order, order.Lines); err != nil {
+			return err
+		}
+
+		order.Status = models.PurchaseOrderDraft
+		if err := tx.Omit(clause.Associations).Create(order).Error; err != nil {
+			return err
+		}
+
+		for i := range order.Lines {
+			order.Lines[i].PurchaseOrderID = order.ID
+		}
+		if err := tx.Omit("Product").Create(&order.Lines).Error; err != nil {
+			return err
+		}
+
+		order.Number = fmt.Sprintf("PO-%06d", order.ID)
+		return tx.Model(order).UpdateColumn("number", order.Number).Error
+	})
+}
+
+func (r *purchaseOrderRepository) FindByID(id uint) (*models.PurchaseOrder, error) {
+	return findPurchaseOrder(r.db, id)
+}
+
+// Update saves a draft purchase order, replacing its lines when lines is not nil
+func (r *purchaseOrderRepository) Update(order *models.PurchaseOrder, lines []models.PurchaseOrderLine) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		current, err := lockPurchaseOrder(tx, order.ID)
+		if err != nil {
+			return err
+		}
+		if current.Status != models.PurchaseOrderDraft {
+			return ErrPurchaseOrderStatus
+		}
+
+		if err := validatePurchaseOrder(tx, order, lines); err != nil {
+			return err
+		}
+		if err := tx.Omit(clause.Associations).Save(order).Error; err != nil {
+			return err
+		}
+
+		if lines == nil {
+			return nil
+		}
+		if err := tx.Where("purchase_order_id = ?", order.ID).Delete(&models.PurchaseOrderLine{}).Error; err != nil {
+			return err
+		}
+		for i := range lines {
+			lines[i].PurchaseOrderID = order.ID
+		}
+		return tx.Omit("Product").Create(&lines).Error
+	})
+}
+
+// Delete removes a purchase order that has not been sent yet
+func (r *purchaseOrderRepository) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		order, err := lockPurchaseOrder(tx, id)
+		if err != nil {
+			return err
+		}
+		if order.Status != models.PurchaseOrderDraft {
+			return ErrPurchaseOrderStatus
+		}
+
+		if err := tx.Where("purchase_order_id = ?", id).Delete(&models.PurchaseOrderLine{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&models.PurchaseOrder{}, id).Error
+	})
+}
+
+func (r *purchaseOrderRepository) List(supplierID *uint, status string, page, pageSize int) ([]models.PurchaseOrder, int64, error) {
+	var orders []models.PurchaseOrder
+	var total int64
+
+	query := r.db.Model(&models.PurchaseOrder{})
+
+	if supplierID != nil && *supplierID > 0 {
+		query = query.Where("supplier_id = ?", *supplierID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	query.Count(&total)
+
+	offset := (page - 1) * pageSize
+	err := query.Preload("Supplier").Preload("Lines", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).Preload("Lines.Product").Order("id DESC").Offset(offset).Limit(pageSize).Find(&orders).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return orders, total, nil
+}
+
+// Send marks a draft purchase order as sent to the supplier
+func (r *purchaseOrderRepository) Send(id uint) (*models.PurchaseOrder, error) {
+	return r.transition(id, models.PurchaseOrderSent, models.PurchaseOrderDraft)
+}
+
+// Close closes a purchase order; any quantity not yet received is abandoned
+func (r *purchaseOrderRepository) Close(id uint) (*models.PurchaseOrder, error) {
+	return r.transition(id, models.PurchaseOrderClosed,
+		models.PurchaseOrderSent, models.PurchaseOrderPartiallyReceived, models.PurchaseOrderReceived)
+}
+
+// Receive books the received quantities into stock at the order's
+// warehouse. Each receipt becomes a receipt movement at the line's unit
+// cost, which also writes the product history row; a line may be received in
+// several lots at once.
+func (r *purchaseOrderRepository) Receive(id uint, receipts []models.ReceiveLineRequest, userID *uint) (*models.PurchaseOrder, []models.StockMovement, error) {
+	var movements []models.StockMovement
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		order, err := lockPurchaseOrder(tx, id)
+		if err != nil {
+			return err
+		}
+		if order.Status != models.PurchaseOrderSent && order.Status != models.PurchaseOrderPartiallyReceived {
+			return ErrPurchaseOrderStatus
+		}
+
+		var lines []models.PurchaseOrderLine
+		if err := tx.Where("purchase_order_id = ?", id).Order("id ASC").Find(&lines).Error; err != nil {
+			return err
+		}
+
+		byID := make(map[uint]*models.PurchaseOrderLine, len(lines))
+		for i := range lines {
+			byID[lines[i].ID] = &lines[i]
+		}
+
+		// Book receipts in product order so product rows are locked consistently
+		sorted := make([]models.ReceiveLineRequest, len(receipts))
+		copy(sorted, receipts)
+		for _, receipt := range sorted {
+			if byID[receipt.LineID] == nil {
+				return ErrPurchaseOrderLineNotFound
+			}
+		}
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return byID[sorted[i].LineID].ProductID < byID[sorted[j].LineID].ProductID
+		})
+
+		for _, receipt := range sorted {
+			line := byID[receipt.LineID]
+			if receipt.Quantity > line.Outstanding() {
+				return ErrOverReceipt
+			}
+
+			line.QuantityReceived += receipt.Quantity
+			if err := tx.Model(line).UpdateColumn("quantity_received", line.QuantityReceived).Error; err != nil {
+				return err
+			}
+
+			// A product's currency may have changed since the order was raised
+			if err := verifyCostCurrency(tx, order.Currency, []uint{line.ProductID}); err != nil {
+				return err
+			}
+
+			unitCost := perBaseUnit(line.UnitCost, line.UnitFactor)
+			movement := models.StockMovement{
+				ProductID:   line.ProductID,
+				WarehouseID: order.WarehouseID,
+				Type:        models.MovementReceipt,
+				Quantity:    receipt.Quantity,
+				UnitCost:    &unitCost,
+				Reason:      "Purchase order receipt",
+				Reference:   order.Number,
+				UserID:      userID,
+				Lots:        receipt.LotInput.Entries(receipt.Quantity),
+				Serials:     receipt.SerialInput.Entries(),
+			}
+			if err := applyStockMovement(tx, &movement); err != nil {
+				return err
+			}
+			movements = append(movements, movement)
+		}
+
+		complete := true
+		for _, line := range lines {
+			if line.Outstanding() > 0 {
+				complete = false
+			}
+		}
+
+		now := time.Now()
+		updates := map[string]interface{}{
+			"status":     models.PurchaseOrderPartiallyReceived,
+			"updated_at": now,
+		}
+		if complete {
+			updates["status"] = models.PurchaseOrderReceived
+			updates["received_at"] = now
+		}
+		return tx.Model(order).Updates(updates).Error
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	order, err := findPurchaseOrder(r.db, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return order, movements, nil
+}
+
+// transition moves a purchase order to status if it is currently in one of from
+func (r *purchaseOrderRepository) transition(id uint, status models.PurchaseOrderStatus, from ...models.PurchaseOrderStatus) (*models.PurchaseOrder, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		order, err := lockPurchaseOrder(tx, id)
+		if err != nil {
+			return err
+		}
+
+		allowed := false
+		for _, s := range from {
+			if order.Status == s {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return ErrPurchaseOrderStatus
+		}
+
+		now := time.Now()
+		updates := map[string]interface{}{
+			"status":     status,
+			"updated_at": now,
+		}
+		switch status {
+		case models.PurchaseOrderSent:
+			updates["sent_at"] = now
+		case models.PurchaseOrderClosed:
+			updates["closed_at"] = now
+		}
+		return tx.Model(order).Updates(updates).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return findPurchaseOrder(r.db, id)
+}
+
+// findPurchaseOrder loads a purchase order with its supplier and lines
+func findPurchaseOrder(db *gorm.DB, id uint) (*models.PurchaseOrder, error) {
+	var order models.PurchaseOrder
+	err := db.Preload("Supplier").Preload("Lines", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).Preload("Lines.Product").First(&order, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPurchaseOrderNotFound
+		}
+		return nil, err
+	}
+	return &order, nil
+}
+
+// lockPurchaseOrder loads a purchase order row with FOR UPDATE
+func lockPurchaseOrder(tx *gorm.DB, id uint) (*models.PurchaseOrder, error) {
+	var order models.PurchaseOrder
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrPurchaseOrderNotFound
+		}
+		return nil, err
+	}
+	return &order, nil
+}
+
+// validatePurchaseOrder checks that the supplier, warehouse and line products
+// exist and that the products are costed in the order's currency, resolving
+// an empty warehouse to the default one. Nil lines check the order's
+// current lines.
+func validatePurchaseOrder(tx *gorm.DB, order *models.PurchaseOrder, lines []models.PurchaseOrderLine) error {
+	var count int64
+	tx.Model(&models.Supplier{}).Where("id = ?", order.SupplierID).Count(&count)
+	if count == 0 {
+		return ErrSupplierNotFound
+	}
+
+	warehouseID, err := resolveWarehouseID(tx, order.WarehouseID)
+	if err != nil {
+		return err
+	}
+	order.WarehouseID = warehouseID
+
+	productIDs := make([]uint, len(lines))
+	for i, line := range lines {
+		productIDs[i] = line.ProductID
+	}
+	if lines == nil && order.ID != 0 {
+		err := tx.Model(&models.PurchaseOrderLine{}).Where("purchase_order_id = ?", order.ID).Pluck("product_id", &productIDs).Error
+		if err != nil {
+			return err
+		}
+	}
+	if err := verifyProductsExist(tx, productIDs); err != nil {
+		return err
+	}
+	return verifyCostCurrency(tx, order.Currency, productIDs)
+}
+
+// verifyCostCurrency checks that the products are costed in currency.
+// Receipts fold the line cost into the product's average cost, which is in
+// the product's currency.
+func verifyCostCurrency(tx *gorm.DB, currency string, productIDs []uint) error {
+	if len(productIDs) == 0 {
+		return nil
+	}
+	var count int64
+	err := tx.Model(&models.Product{}).Where("id IN ? AND currency <> ?", productIDs, currency).Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrCostCurrency
+	}
+	return nil
+}
+
+// perBaseUnit converts a cost per unit holding factor base units into a
+// cost per base unit, rounded to the cost precision
+func perBaseUnit(cost models.Amount, factor models.Quantity) models.Cost {
+	return cost.Cost().Div(factor)
+}
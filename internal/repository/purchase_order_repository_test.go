@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+)
+
+func TestPerBaseUnit(t *testing.T) {
+	// Costs are in hundredths, factors in thousandths and the results in
+	// ten-thousandths
+	tests := []struct {
+		name   string
+		cost   models.Amount
+		factor models.Quantity
+		want   models.Cost
+	}{
+		{"base unit", 349, 1000, 34900},
+		{"no factor", 349, 0, 34900},
+		{"box of 100", 349, 100000, 349},
+		{"box of 12", 1000, 12000, 8333},
+		{"rounds half up", 1, 8000, 13},
+		{"fractional factor", 500, 500, 100000},
+		{"free", 0, 100000, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := perBaseUnit(tt.cost, tt.factor); got != tt.want {
+				t.Errorf("perBaseUnit(%v, %v) = %v, want %v", tt.cost, tt.factor, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,355 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrReservationNotFound      = errors.New("reservation not found")
+	ErrReservationNotActive     = errors.New("reservation is not active")
+	ErrReservationExpired       = errors.New("reservation has expired")
+	ErrInsufficientAvailability = errors.New("insufficient available stock")
+	ErrReservationOwnedByOrder  = errors.New("reservation belongs to a sales order")
+)
+
+type ReservationRepository interface {
+	Create(reservation *models.Reservation) error
+	FindByID(id uint) (*models.Reservation, error)
+	List(productID *uint, status string, page, pageSize int) ([]models.Reservation, int64, error)
+	Confirm(id, warehouseID uint, serials []models.StockMovementSerial, userID *uint) (*models.Reservation, *models.StockMovement, error)
+	Release(id uint) (*models.Reservation, error)
+	ReleaseExpired(now time.Time) ([]models.Reservation, error)
+}
+
+type reservationRepository struct {
+	db *gorm.DB
+}
+
+func NewReservationRepository(db *gorm.DB) ReservationRepository {
+	return &reservationRepository{db: db}
+}
+
+// Create holds stock for the reservation if enough is available
+func (r *reservationRepository) Create(reservation *models.Reservation) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return reserveStock(tx, reservation)
+	})
+}
+
+func (r *reservationRepository) FindByID(id uint) (*models.Reservation, error) {
+	var reservation models.Reservation
+	err := r.db.First(&reservation, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReservationNotFound
+		}
+		return nil, err
+	}
+	return &reservation, nil
+}
+
+func (r *reservationRepository) List(productID *uint, status string, page, pageSize int) ([]models.Reservation, int64, error) {
+	var reservations []models.Reservation
+	var total int64
+
+	query := r.db.Model(&models.Reservation{})
+
+	if productID != nil && *productID > 0 {
+		query = query.Where("product_id = ?", *productID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	query.Count(&total)
+
+	offset := (page - 1) * pageSize
+	err := query.Order("id DESC").Offset(offset).Limit(pageSize).Find(&reservations).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return reservations, total, nil
+}
+
+// Confirm turns an active reservation into a sale, decrementing stock at
+// the given warehouse (0 for the default one). Serialized products must list
+// the units sold. Reservations of sales orders are confirmed by shipping the
+// order instead.
+func (r *reservationRepository) Confirm(id, warehouseID uint, serials []models.StockMovementSerial, userID *uint) (*models.Reservation, *models.StockMovement, error) {
+	var reservation *models.Reservation
+	var movement *models.StockMovement
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		reservation, err = lockActiveReservation(tx, id)
+		if err != nil {
+			return err
+		}
+		if err := checkNotOrderReservation(tx, id); err != nil {
+			return err
+		}
+		// Expired holds are left for the sweeper to release
+		if reservation.ExpiresAt != nil && !reservation.ExpiresAt.After(time.Now()) {
+			return ErrReservationExpired
+		}
+
+		if err := releaseStock(tx, reservation, models.ReservationConfirmed); err != nil {
+			return err
+		}
+
+		reference := reservation.Reference
+		if reference == "" {
+			reference = fmt.Sprintf("RES-%d", reservation.ID)
+		}
+		movement = &models.StockMovement{
+			ProductID:   reservation.ProductID,
+			WarehouseID: warehouseID,
+			Type:        models.MovementSale,
+			Quantity:    -reservation.Quantity,
+			Reason:      "Reservation confirmed",
+			Reference:   reference,
+			UserID:      userID,
+			Serials:     serials,
+		}
+		return applyStockMovement(tx, movement)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return reservation, movement, nil
+}
+
+// Release frees the stock held by an active reservation. Reservations of
+// sales orders are released by cancelling the order instead.
+func (r *reservationRepository) Release(id uint) (*models.Reservation, error) {
+	var reservation *models.Reservation
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		reservation, err = lockActiveReservation(tx, id)
+		if err != nil {
+			return err
+		}
+		if err := checkNotOrderReservation(tx, id); err != nil {
+			return err
+		}
+		return releaseStock(tx, reservation, models.ReservationReleased)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reservation, nil
+}
+
+// ReleaseExpired marks every active reservation past its expiry as expired
+// and returns the reservations that were released
+func (r *reservationRepository) ReleaseExpired(now time.Time) ([]models.Reservation, error) {
+	var candidates []models.Reservation
+	err := r.db.Where("status = ? AND expires_at IS NOT NULL AND expires_at <= ?", models.ReservationActive, now).
+		Order("id ASC").Find(&candidates).Error
+	if err != nil {
+		return nil, err
+	}
+
+	var released []models.Reservation
+	for _, candidate := range candidates {
+		err := r.db.Transaction(func(tx *gorm.DB) error {
+			reservation, err := lockActiveReservation(tx, candidate.ID)
+			if err != nil {
+				return err
+			}
+			if err := releaseStock(tx, reservation, models.ReservationExpired); err != nil {
+				return err
+			}
+			released = append(released, *reservation)
+			return nil
+		})
+		// Another request may have confirmed or released it meanwhile
+		if err != nil && !errors.Is(err, ErrReservationNotActive) {
+			return released, err
+		}
+	}
+
+	return released, nil
+}
+
+// reserveStock inserts the reservation and increases the product's reserved
+// quantity, failing if the product does not have enough available stock, or
+// for a reservation at a warehouse, if that warehouse does not have enough
+// stock that is not already held there. Reserving a bundle holds its
+// components instead. It must run inside a transaction.
+func reserveStock(tx *gorm.DB, reservation *models.Reservation) error {
+	product, err := lockProduct(tx, reservation.ProductID)
+	if err != nil {
+		return err
+	}
+
+	holds, err := reservationHolds(tx, reservation)
+	if err != nil {
+		return err
+	}
+	for _, hold := range holds {
+		held := product
+		if hold.productID != product.ID {
+			if held, err = lockProduct(tx, hold.productID); err != nil {
+				return err
+			}
+		}
+		if !held.ValidQuantity(hold.quantity) {
+			return ErrQuantityPrecision
+		}
+		if hold.quantity > held.Available() {
+			return ErrInsufficientAvailability
+		}
+		if reservation.WarehouseID != nil {
+			available, err := availableAtWarehouse(tx, hold.productID, *reservation.WarehouseID)
+			if err != nil {
+				return err
+			}
+			if hold.quantity > available {
+				return ErrInsufficientAvailability
+			}
+		}
+	}
+
+	reservation.Status = models.ReservationActive
+	if err := tx.Omit("Product").Create(reservation).Error; err != nil {
+		return err
+	}
+
+	for _, hold := range holds {
+		err := tx.Model(&models.Product{}).Where("id = ?", hold.productID).
+			UpdateColumn("reserved", gorm.Expr("reserved + ?", hold.quantity)).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// reservationHold is the quantity a reservation holds of one product
+type reservationHold struct {
+	productID uint
+	quantity  models.Quantity
+}
+
+// reservationHolds returns what a reservation holds: the reserved product
+// itself, or each component of a bundle in component ID order
+func reservationHolds(tx *gorm.DB, reservation *models.Reservation) ([]reservationHold, error) {
+	components, err := bundleComponents(tx, reservation.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if len(components) == 0 {
+		return []reservationHold{{productID: reservation.ProductID, quantity: reservation.Quantity}}, nil
+	}
+
+	holds := make([]reservationHold, len(components))
+	for i, component := range components {
+		holds[i] = reservationHold{productID: component.ComponentID, quantity: reservation.Quantity.Mul(component.Quantity)}
+	}
+	return holds, nil
+}
+
+// lockActiveReservation loads a reservation and its product with FOR UPDATE
+// and checks that the reservation is still active
+func lockActiveReservation(tx *gorm.DB, id uint) (*models.Reservation, error) {
+	var reservation models.Reservation
+	if err := tx.First(&reservation, id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReservationNotFound
+		}
+		return nil, err
+	}
+
+	// Lock the product first so reservation and stock changes share one lock order
+	if _, err := lockProduct(tx, reservation.ProductID); err != nil {
+		return nil, err
+	}
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&reservation, id).Error; err != nil {
+		return nil, err
+	}
+
+	if reservation.Status != models.ReservationActive {
+		return nil, ErrReservationNotActive
+	}
+	return &reservation, nil
+}
+
+// releaseStock moves a locked active reservation to the given status and
+// frees its quantity on the product, or on the components of a bundle
+func releaseStock(tx *gorm.DB, reservation *models.Reservation, status models.ReservationStatus) error {
+	reservation.Status = status
+	reservation.UpdatedAt = time.Now()
+	err := tx.Model(reservation).Updates(map[string]interface{}{
+		"status":     reservation.Status,
+		"updated_at": reservation.UpdatedAt,
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	holds, err := reservationHolds(tx, reservation)
+	if err != nil {
+		return err
+	}
+	for _, hold := range holds {
+		err := tx.Model(&models.Product{}).Where("id = ?", hold.productID).
+			UpdateColumn("reserved", gorm.Expr("GREATEST(reserved - ?, 0)", hold.quantity)).Error
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// availableAtWarehouse returns a product's stock at a warehouse less what
+// active reservations at that warehouse hold of it
+func availableAtWarehouse(tx *gorm.DB, productID, warehouseID uint) (models.Quantity, error) {
+	var onHand models.Quantity
+	err := tx.Model(&models.ProductStock{}).Select("COALESCE(SUM(quantity), 0)").
+		Where("product_id = ? AND warehouse_id = ?", productID, warehouseID).
+		Scan(&onHand).Error
+	if err != nil {
+		return 0, err
+	}
+	held, err := heldAtWarehouse(tx, productID, warehouseID)
+	if err != nil {
+		return 0, err
+	}
+	return onHand - held, nil
+}
+
+// heldAtWarehouse returns how much of a product active reservations at a
+// warehouse hold, directly or as a component of a reserved bundle
+func heldAtWarehouse(tx *gorm.DB, productID, warehouseID uint) (models.Quantity, error) {
+	var held models.Quantity
+	err := tx.Table("reservations r").
+		Select("COALESCE(SUM(ROUND(r.quantity * COALESCE(bc.quantity, 1), 3)), 0)").
+		Joins("LEFT JOIN bundle_components bc ON bc.bundle_id = r.product_id AND bc.component_id = ?", productID).
+		Where("r.status = ? AND r.warehouse_id = ?", models.ReservationActive, warehouseID).
+		Where("r.product_id = ? OR bc.component_id IS NOT NULL", productID).
+		Scan(&held).Error
+	if err != nil {
+		return 0, err
+	}
+	return held, nil
+}
+
+// checkNotOrderReservation fails with ErrReservationOwnedByOrder if a sales
+// order line holds the reservation, so the order cannot lose its stock
+func checkNotOrderReservation(tx *gorm.DB, id uint) error {
+	var count int64
+	if err := tx.Model(&models.SalesOrderLine{}).Where("reservation_id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrReservationOwnedByOrder
+	}
+	return nil
+}
@@ -0,0 +1,392 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrReturnNotFound = errors.New("return not found")
+	ErrReturnStatus   = errors.New("return status does not allow this action")
+	ErrReturnTracking = errors.New("serial and lot numbers only apply to products tracked by them")
+)
+
+type ReturnRepository interface {
+	Create(ret *models.ReturnAuthorization) error
+	FindByID(id uint) (*models.ReturnAuthorization, error)
+	List(productID uint, status, disposition string, page, pageSize int) ([]models.ReturnAuthorization, int64, error)
+	Inspect(id uint, condition models.ReturnCondition, notes string, userID *uint) (*models.ReturnAuthorization, error)
+	Dispose(id uint, disposition models.ReturnDisposition, warehouseID uint, notes string, userID *uint) (*models.ReturnAuthorization, *models.StockMovement, error)
+	Summary(productID uint, start, end *time.Time) (*models.ReturnSummary, error)
+}
+
+type returnRepository struct {
+	db *gorm.DB
+}
+
+func NewReturnRepository(db *gorm.DB) ReturnRepository {
+	return &returnRepository{db: db}
+}
+
+// Create records a pending return and assigns its number. A serialized
+// product is returned one unit at a time, naming a unit of the product that
+// is not currently in stock; serials never seen before are accepted and
+// registered when the unit is restocked.
+func (r *returnRepository) Create(ret *models.ReturnAuthorization) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		product, err := lockProduct(tx, ret.ProductID)
+		if err != nil {
+			return err
+		}
+		if !product.ValidQuantity(ret.Quantity) {
+			return ErrQuantityPrecision
+		}
+
+		if (ret.Serial != "" && !product.Serialized) || (ret.LotNumber != "" && !product.LotTracked) {
+			return ErrReturnTracking
+		}
+		if product.Serialized {
+			if ret.Serial == "" || ret.Quantity != models.Units(1) {
+				return ErrSerialCountMismatch
+			}
+			unit, err := findReturnedUnit(tx, ret.Serial)
+			if err != nil {
+				return err
+			}
+			if unit != nil && (unit.ProductID != ret.ProductID ||
+				unit.Status == models.SerialInStock || unit.Status == models.SerialInTransit) {
+				return ErrSerialUnavailable
+			}
+		}
+
+		ret.Status = models.ReturnPending
+		if err := tx.Omit(clause.Associations).Create(ret).Error; err != nil {
+			return err
+		}
+
+		ret.Number = fmt.Sprintf("RMA-%06d", ret.ID)
+		if err := tx.Model(ret).UpdateColumn("number", ret.Number).Error; err != nil {
+			return err
+		}
+
+		return addReturnEvent(tx, ret, ret.Reason, ret.UserID)
+	})
+}
+
+// FindByID returns a return with its product and processing history
+func (r *returnRepository) FindByID(id uint) (*models.ReturnAuthorization, error) {
+	return findReturn(r.db, id)
+}
+
+func (r *returnRepository) List(productID uint, status, disposition string, page, pageSize int) ([]models.ReturnAuthorization, int64, error) {
+	var returns []models.ReturnAuthorization
+	var total int64
+
+	query := r.db.Model(&models.ReturnAuthorization{})
+
+	if productID != 0 {
+		query = query.Where("product_id = ?", productID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	if disposition != "" {
+		query = query.Where("disposition = ?", disposition)
+	}
+
+	query.Count(&total)
+
+	offset := (page - 1) * pageSize
+	err := query.Preload("Product").Order("id DESC").Offset(offset).Limit(pageSize).Find(&returns).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return returns, total, nil
+}
+
+// Inspect records the condition a pending return was found in
+func (r *returnRepository) Inspect(id uint, condition models.ReturnCondition, notes string, userID *uint) (*models.ReturnAuthorization, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		ret, err := lockReturn(tx, id, models.ReturnPending)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		ret.Status = models.ReturnInspected
+		ret.Condition = condition
+		ret.InspectedAt = &now
+		err = tx.Model(ret).Updates(map[string]interface{}{
+			"status":       ret.Status,
+			"condition":    ret.Condition,
+			"inspected_at": now,
+			"updated_at":   now,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return addReturnEvent(tx, ret, notes, userID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return findReturn(r.db, id)
+}
+
+// Dispose settles an inspected return. Restocking records a return movement
+// into the warehouse, bringing a returned unit back into stock and filling
+// the named lot. Refurbish and scrap leave stock and the ledger unchanged;
+// they move a returned unit to refurbishing or written_off and record that
+// in the unit's events. The movement is nil unless the return was
+// restocked.
+func (r *returnRepository) Dispose(id uint, disposition models.ReturnDisposition, warehouseID uint, notes string, userID *uint) (*models.ReturnAuthorization, *models.StockMovement, error) {
+	var movement *models.StockMovement
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		ret, err := lockReturn(tx, id, models.ReturnInspected)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		updates := map[string]interface{}{
+			"status":      models.ReturnDisposed,
+			"disposition": disposition,
+			"disposed_at": now,
+			"updated_at":  now,
+		}
+
+		switch disposition {
+		case models.ReturnRestock:
+			movement = &models.StockMovement{
+				ProductID:   ret.ProductID,
+				WarehouseID: warehouseID,
+				Type:        models.MovementReturn,
+				Quantity:    ret.Quantity,
+				Reason:      "Restocked from return " + ret.Number,
+				Reference:   ret.Number,
+				UserID:      userID,
+				Lots:        models.LotInput{LotNumber: ret.LotNumber}.Entries(ret.Quantity),
+			}
+			if ret.Serial != "" {
+				movement.Serials = models.SerialInput{Serials: []string{ret.Serial}}.Entries()
+			}
+			if err := applyStockMovement(tx, movement); err != nil {
+				return err
+			}
+			updates["warehouse_id"] = movement.WarehouseID
+			updates["movement_id"] = movement.ID
+			ret.WarehouseID = &movement.WarehouseID
+			ret.MovementID = &movement.ID
+		case models.ReturnRefurbish:
+			if err := setReturnedUnitStatus(tx, ret, models.SerialRefurbishing, "Sent for refurbishment from return "+ret.Number, userID); err != nil {
+				return err
+			}
+		case models.ReturnScrap:
+			if err := setReturnedUnitStatus(tx, ret, models.SerialWrittenOff, "Scrapped from return "+ret.Number, userID); err != nil {
+				return err
+			}
+		}
+
+		ret.Status = models.ReturnDisposed
+		ret.Disposition = disposition
+		ret.DisposedAt = &now
+		if err := tx.Model(ret).Updates(updates).Error; err != nil {
+			return err
+		}
+
+		return addReturnEvent(tx, ret, notes, userID)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ret, err := findReturn(r.db, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ret, movement, nil
+}
+
+// Summary totals the returns created in the period, optionally for one
+// product, grouped by status, condition and disposition
+func (r *returnRepository) Summary(productID uint, start, end *time.Time) (*models.ReturnSummary, error) {
+	summary := &models.ReturnSummary{
+		Start:         start,
+		End:           end,
+		ByStatus:      make(map[string]models.ReturnTotal),
+		ByCondition:   make(map[string]models.ReturnTotal),
+		ByDisposition: make(map[string]models.ReturnTotal),
+	}
+	if productID != 0 {
+		summary.ProductID = &productID
+	}
+
+	scope := func(db *gorm.DB) *gorm.DB {
+		db = db.Model(&models.ReturnAuthorization{})
+		if productID != 0 {
+			db = db.Where("product_id = ?", productID)
+		}
+		if start != nil {
+			db = db.Where("created_at >= ?", *start)
+		}
+		if end != nil {
+			db = db.Where("created_at <= ?", *end)
+		}
+		return db
+	}
+
+	type groupTotal struct {
+		Name     string
+		Count    int64
+		Quantity models.Quantity
+	}
+	groups := []struct {
+		column string
+		totals map[string]models.ReturnTotal
+	}{
+		{"status", summary.ByStatus},
+		{"condition", summary.ByCondition},
+		{"disposition", summary.ByDisposition},
+	}
+	for _, group := range groups {
+		var rows []groupTotal
+		err := r.db.Scopes(scope).
+			Select(group.column + " AS name, COUNT(*) AS count, COALESCE(SUM(quantity), 0) AS quantity").
+			Where(group.column + " <> ''").
+			Group(group.column).
+			Scan(&rows).Error
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			group.totals[row.Name] = models.ReturnTotal{Count: row.Count, Quantity: row.Quantity}
+		}
+	}
+
+	for _, total := range summary.ByStatus {
+		summary.Total.Count += total.Count
+		summary.Total.Quantity += total.Quantity
+	}
+
+	var disposed models.Quantity
+	for _, total := range summary.ByDisposition {
+		disposed += total.Quantity
+	}
+	if disposed > 0 {
+		summary.RestockRate = float64(summary.ByDisposition[string(models.ReturnRestock)].Quantity) / float64(disposed)
+	}
+
+	return summary, nil
+}
+
+func findReturn(db *gorm.DB, id uint) (*models.ReturnAuthorization, error) {
+	var ret models.ReturnAuthorization
+	err := db.Preload("Product").Preload("Events", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).First(&ret, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReturnNotFound
+		}
+		return nil, err
+	}
+	return &ret, nil
+}
+
+// lockReturn loads a return row with FOR UPDATE and checks that it is in
+// the given status
+func lockReturn(tx *gorm.DB, id uint, status models.ReturnStatus) (*models.ReturnAuthorization, error) {
+	var ret models.ReturnAuthorization
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&ret, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrReturnNotFound
+		}
+		return nil, err
+	}
+	if ret.Status != status {
+		return nil, ErrReturnStatus
+	}
+	return &ret, nil
+}
+
+// findReturnedUnit locks a returned unit by serial, returning nil if the
+// serial has never been seen
+func findReturnedUnit(tx *gorm.DB, serial string) (*models.SerialNumber, error) {
+	var unit models.SerialNumber
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("serial = ?", serial).First(&unit).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &unit, nil
+}
+
+// setReturnedUnitStatus moves the unit named by a return out of stock into
+// the given status, registering its serial if it has never been seen, and
+// records the change in the unit's events with the given reason. It does
+// nothing for returns of unserialized stock.
+func setReturnedUnitStatus(tx *gorm.DB, ret *models.ReturnAuthorization, status models.SerialStatus, reason string, userID *uint) error {
+	if ret.Serial == "" {
+		return nil
+	}
+
+	unit, err := findReturnedUnit(tx, ret.Serial)
+	if err != nil {
+		return err
+	}
+	if unit == nil {
+		unit = &models.SerialNumber{
+			ProductID: ret.ProductID,
+			Serial:    ret.Serial,
+			Status:    status,
+		}
+		if err := tx.Omit(clause.Associations).Create(unit).Error; err != nil {
+			return err
+		}
+	} else {
+		if unit.ProductID != ret.ProductID || unit.Status == models.SerialInStock || unit.Status == models.SerialInTransit {
+			return ErrSerialUnavailable
+		}
+		err := tx.Model(unit).Updates(map[string]interface{}{
+			"status":       status,
+			"warehouse_id": nil,
+			"updated_at":   time.Now(),
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	event := &models.SerialEvent{
+		SerialNumberID: unit.ID,
+		Status:         status,
+		Reason:         reason,
+		Reference:      ret.Number,
+		UserID:         userID,
+	}
+	return tx.Create(event).Error
+}
+
+// addReturnEvent records the return's current state in its history
+func addReturnEvent(tx *gorm.DB, ret *models.ReturnAuthorization, notes string, userID *uint) error {
+	event := &models.ReturnEvent{
+		ReturnID:    ret.ID,
+		Status:      ret.Status,
+		Condition:   ret.Condition,
+		Disposition: ret.Disposition,
+		Notes:       notes,
+		UserID:      userID,
+	}
+	return tx.Create(event).Error
+}
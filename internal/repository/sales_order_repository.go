@@ -0,0 +1,320 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrSalesOrderNotFound = errors.New("sales order not found")
+	ErrSalesOrderStatus   = errors.New("sales order status does not allow this action")
+)
+
+type SalesOrderRepository interface {
+	Create(order *models.SalesOrder) error
+	FindByID(id uint) (*models.SalesOrder, error)
+	List(status string, page, pageSize int) ([]models.SalesOrder, int64, error)
+	Allocate(id uint, userID *uint) (*models.SalesOrder, error)
+	Pick(id uint) (*models.SalesOrder, error)
+	Ship(id uint, serials map[uint]models.SerialInput, userID *uint) (*models.SalesOrder, []models.StockMovement, error)
+	Cancel(id uint) (*models.SalesOrder, error)
+}
+
+type salesOrderRepository struct {
+	db *gorm.DB
+}
+
+func NewSalesOrderRepository(db *gorm.DB) SalesOrderRepository {
+	return &salesOrderRepository{db: db}
+}
+
+// Create inserts a pending sales order with its lines and assigns its number
+func (r *salesOrderRepository) Create(order *models.SalesOrder) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		warehouseID, err := resolveWarehouseID(tx, order.WarehouseID)
+		if err != nil {
+			return err
+		}
+		order.WarehouseID = warehouseID
+
+		productIDs := make([]uint, len(order.Lines))
+		for i, line := range order.Lines {
+			productIDs[i] = line.ProductID
+		}
+		if err := verifyProductsExist(tx, productIDs); err != nil {
+			return err
+		}
+
+		order.Status = models.SalesOrderPending
+		if err := tx.Omit(clause.Associations).Create(order).Error; err != nil {
+			return err
+		}
+
+		for i := range order.Lines {
+			order.Lines[i].SalesOrderID = order.ID
+		}
+		if err := tx.Omit("Product").Create(&order.Lines).Error; err != nil {
+			return err
+		}
+
+		order.Number = fmt.Sprintf("SO-%06d", order.ID)
+		return tx.Model(order).UpdateColumn("number", order.Number).Error
+	})
+}
+
+func (r *salesOrderRepository) FindByID(id uint) (*models.SalesOrder, error) {
+	return findSalesOrder(r.db, id)
+}
+
+func (r *salesOrderRepository) List(status string, page, pageSize int) ([]models.SalesOrder, int64, error) {
+	var orders []models.SalesOrder
+	var total int64
+
+	query := r.db.Model(&models.SalesOrder{})
+
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	query.Count(&total)
+
+	offset := (page - 1) * pageSize
+	err := query.Preload("Lines", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).Preload("Lines.Product").Order("id DESC").Offset(offset).Limit(pageSize).Find(&orders).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return orders, total, nil
+}
+
+// Allocate reserves the stock for every line of a pending order at the
+// order's warehouse. Either all lines are reserved or, if any product lacks
+// available stock there, none are.
+func (r *salesOrderRepository) Allocate(id uint, userID *uint) (*models.SalesOrder, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		order, err := lockSalesOrder(tx, id, models.SalesOrderPending)
+		if err != nil {
+			return err
+		}
+		warehouseID, err := resolveWarehouseID(tx, order.WarehouseID)
+		if err != nil {
+			return err
+		}
+
+		lines, err := salesOrderLines(tx, id)
+		if err != nil {
+			return err
+		}
+
+		for i := range lines {
+			reservation := &models.Reservation{
+				ProductID:   lines[i].ProductID,
+				WarehouseID: &warehouseID,
+				Quantity:    lines[i].Quantity,
+				Reference:   order.Number,
+				UserID:      userID,
+			}
+			if err := reserveStock(tx, reservation); err != nil {
+				return err
+			}
+			if err := tx.Model(&lines[i]).UpdateColumn("reservation_id", reservation.ID).Error; err != nil {
+				return err
+			}
+		}
+
+		return setSalesOrderStatus(tx, order, models.SalesOrderAllocated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return findSalesOrder(r.db, id)
+}
+
+// Pick records that an allocated order has been picked
+func (r *salesOrderRepository) Pick(id uint) (*models.SalesOrder, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		order, err := lockSalesOrder(tx, id, models.SalesOrderAllocated)
+		if err != nil {
+			return err
+		}
+		return setSalesOrderStatus(tx, order, models.SalesOrderPicked)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return findSalesOrder(r.db, id)
+}
+
+// Ship confirms the reservations of a picked order and decrements stock at
+// its warehouse with one sale movement per line, all in one transaction.
+// serials holds the units shipped for lines of serialized products.
+func (r *salesOrderRepository) Ship(id uint, serials map[uint]models.SerialInput, userID *uint) (*models.SalesOrder, []models.StockMovement, error) {
+	var movements []models.StockMovement
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		order, err := lockSalesOrder(tx, id, models.SalesOrderPicked)
+		if err != nil {
+			return err
+		}
+
+		lines, err := salesOrderLines(tx, id)
+		if err != nil {
+			return err
+		}
+
+		for _, line := range lines {
+			if line.ReservationID == nil {
+				return ErrReservationNotFound
+			}
+			reservation, err := lockActiveReservation(tx, *line.ReservationID)
+			if err != nil {
+				return err
+			}
+			if err := releaseStock(tx, reservation, models.ReservationConfirmed); err != nil {
+				return err
+			}
+
+			movement := models.StockMovement{
+				ProductID:   line.ProductID,
+				WarehouseID: order.WarehouseID,
+				Type:        models.MovementSale,
+				Quantity:    -line.Quantity,
+				Reason:      "Sales order shipped",
+				Reference:   order.Number,
+				UserID:      userID,
+				Serials:     serials[line.ID].Entries(),
+			}
+			if err := applyStockMovement(tx, &movement); err != nil {
+				return err
+			}
+			movements = append(movements, movement)
+		}
+
+		return setSalesOrderStatus(tx, order, models.SalesOrderShipped)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	order, err := findSalesOrder(r.db, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return order, movements, nil
+}
+
+// Cancel cancels an order that has not shipped, releasing any stock it holds
+func (r *salesOrderRepository) Cancel(id uint) (*models.SalesOrder, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		order, err := lockSalesOrder(tx, id, models.SalesOrderPending, models.SalesOrderAllocated, models.SalesOrderPicked)
+		if err != nil {
+			return err
+		}
+
+		lines, err := salesOrderLines(tx, id)
+		if err != nil {
+			return err
+		}
+
+		for _, line := range lines {
+			if line.ReservationID == nil {
+				continue
+			}
+			reservation, err := lockActiveReservation(tx, *line.ReservationID)
+			if errors.Is(err, ErrReservationNotActive) || errors.Is(err, ErrReservationNotFound) {
+				// Nothing is held any more
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if err := releaseStock(tx, reservation, models.ReservationReleased); err != nil {
+				return err
+			}
+		}
+
+		return setSalesOrderStatus(tx, order, models.SalesOrderCancelled)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return findSalesOrder(r.db, id)
+}
+
+// findSalesOrder loads a sales order with its lines
+func findSalesOrder(db *gorm.DB, id uint) (*models.SalesOrder, error) {
+	var order models.SalesOrder
+	err := db.Preload("Lines", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).Preload("Lines.Product").First(&order, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSalesOrderNotFound
+		}
+		return nil, err
+	}
+	return &order, nil
+}
+
+// lockSalesOrder loads a sales order row with FOR UPDATE and checks that it
+// is in one of the given statuses
+func lockSalesOrder(tx *gorm.DB, id uint, statuses ...models.SalesOrderStatus) (*models.SalesOrder, error) {
+	var order models.SalesOrder
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSalesOrderNotFound
+		}
+		return nil, err
+	}
+
+	for _, status := range statuses {
+		if order.Status == status {
+			return &order, nil
+		}
+	}
+	return nil, ErrSalesOrderStatus
+}
+
+// salesOrderLines returns an order's lines sorted by product so that product
+// rows are always locked in the same order
+func salesOrderLines(tx *gorm.DB, orderID uint) ([]models.SalesOrderLine, error) {
+	var lines []models.SalesOrderLine
+	if err := tx.Where("sales_order_id = ?", orderID).Order("id ASC").Find(&lines).Error; err != nil {
+		return nil, err
+	}
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].ProductID < lines[j].ProductID
+	})
+	return lines, nil
+}
+
+// setSalesOrderStatus moves a locked order to status and stamps the matching time
+func setSalesOrderStatus(tx *gorm.DB, order *models.SalesOrder, status models.SalesOrderStatus) error {
+	now := time.Now()
+	updates := map[string]interface{}{
+		"status":     status,
+		"updated_at": now,
+	}
+	switch status {
+	case models.SalesOrderAllocated:
+		updates["allocated_at"] = now
+	case models.SalesOrderPicked:
+		updates["picked_at"] = now
+	case models.SalesOrderShipped:
+		updates["shipped_at"] = now
+	case models.SalesOrderCancelled:
+		updates["cancelled_at"] = now
+	}
+	return tx.Model(order).Updates(updates).Error
+}
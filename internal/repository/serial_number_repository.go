@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrSerialNotFound      = errors.New("serial number not found")
+	ErrSerialCountMismatch = errors.New("serial numbers must list exactly the units moved")
+	ErrSerialUnavailable   = errors.New("serial number cannot take part in this movement")
+)
+
+type SerialNumberRepository interface {
+	FindBySerial(serial string) (*models.SerialNumber, error)
+	ListMovements(serialNumberID uint) ([]models.StockMovement, error)
+	ListEvents(serialNumberID uint) ([]models.SerialEvent, error)
+}
+
+type serialNumberRepository struct {
+	db *gorm.DB
+}
+
+func NewSerialNumberRepository(db *gorm.DB) SerialNumberRepository {
+	return &serialNumberRepository{db: db}
+}
+
+func (r *serialNumberRepository) FindBySerial(serial string) (*models.SerialNumber, error) {
+	var unit models.SerialNumber
+	err := r.db.Preload("Product").Where("serial = ?", serial).First(&unit).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSerialNotFound
+		}
+		return nil, err
+	}
+	return &unit, nil
+}
+
+// ListMovements returns every movement that named the unit, oldest first
+func (r *serialNumberRepository) ListMovements(serialNumberID uint) ([]models.StockMovement, error) {
+	var movements []models.StockMovement
+	err := r.db.Where("id IN (?)", r.db.Model(&models.StockMovementSerial{}).Select("movement_id").Where("serial_number_id = ?", serialNumberID)).
+		Preload("Lots.Lot").Preload("Serials.SerialNumber").Order("created_at ASC, id ASC").Find(&movements).Error
+	if err != nil {
+		return nil, err
+	}
+	return movements, nil
+}
+
+// ListEvents returns the unit's status changes outside movements, oldest
+// first
+func (r *serialNumberRepository) ListEvents(serialNumberID uint) ([]models.SerialEvent, error) {
+	var events []models.SerialEvent
+	err := r.db.Where("serial_number_id = ?", serialNumberID).Order("created_at ASC, id ASC").Find(&events).Error
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// serialStatusAfter returns the status a unit takes when an outbound
+// movement of the given type removes it from stock
+func serialStatusAfter(movementType models.MovementType) models.SerialStatus {
+	switch movementType {
+	case models.MovementSale:
+		return models.SerialSold
+	case models.MovementDamage:
+		return models.SerialDamaged
+	case models.MovementTransferOut:
+		return models.SerialInTransit
+	case models.MovementConsumption:
+		return models.SerialConsumed
+	default:
+		return models.SerialWrittenOff
+	}
+}
+
+// applySerialMovement moves the units of a serialized product named in
+// movement.Serials, which must list one distinct serial per unit of
+// quantity. Inbound movements bring units into stock at the warehouse,
+// registering serials seen for the first time; transfer_in only accepts
+// units in transit and other inbound types reject them. Outbound movements
+// take units that are in stock at the warehouse. It must run inside a
+// transaction with the product locked.
+func applySerialMovement(tx *gorm.DB, movement *models.StockMovement) error {
+	if models.Units(int64(len(movement.Serials))) != movement.Quantity.Abs() {
+		return ErrSerialCountMismatch
+	}
+
+	serials := make([]string, len(movement.Serials))
+	for i, entry := range movement.Serials {
+		serials[i] = entry.SerialNumber.Serial
+	}
+	sort.Strings(serials)
+	for i := 1; i < len(serials); i++ {
+		if serials[i] == serials[i-1] {
+			return ErrSerialCountMismatch
+		}
+	}
+
+	var units []models.SerialNumber
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("serial IN ?", serials).Order("serial ASC").Find(&units).Error
+	if err != nil {
+		return err
+	}
+	bySerial := make(map[string]*models.SerialNumber, len(units))
+	for i := range units {
+		bySerial[units[i].Serial] = &units[i]
+	}
+
+	now := time.Now()
+	for i := range movement.Serials {
+		entry := &movement.Serials[i]
+		unit := bySerial[entry.SerialNumber.Serial]
+
+		if movement.Quantity > 0 {
+			if unit == nil {
+				if movement.Type == models.MovementTransferIn {
+					return ErrSerialUnavailable
+				}
+				warehouseID := movement.WarehouseID
+				unit = &models.SerialNumber{
+					ProductID:   movement.ProductID,
+					Serial:      entry.SerialNumber.Serial,
+					Status:      models.SerialInStock,
+					WarehouseID: &warehouseID,
+				}
+				if err := tx.Omit(clause.Associations).Create(unit).Error; err != nil {
+					return err
+				}
+				entry.SerialNumberID = unit.ID
+				entry.SerialNumber = *unit
+				continue
+			}
+			if unit.ProductID != movement.ProductID || unit.Status == models.SerialInStock ||
+				(unit.Status == models.SerialInTransit) != (movement.Type == models.MovementTransferIn) {
+				return ErrSerialUnavailable
+			}
+			warehouseID := movement.WarehouseID
+			unit.Status = models.SerialInStock
+			unit.WarehouseID = &warehouseID
+		} else {
+			if unit == nil {
+				return ErrSerialNotFound
+			}
+			if unit.ProductID != movement.ProductID || unit.Status != models.SerialInStock ||
+				unit.WarehouseID == nil || *unit.WarehouseID != movement.WarehouseID {
+				return ErrSerialUnavailable
+			}
+			unit.Status = serialStatusAfter(movement.Type)
+			unit.WarehouseID = nil
+		}
+
+		unit.UpdatedAt = now
+		err := tx.Model(unit).Updates(map[string]interface{}{
+			"status":       unit.Status,
+			"warehouse_id": unit.WarehouseID,
+			"updated_at":   unit.UpdatedAt,
+		}).Error
+		if err != nil {
+			return err
+		}
+		entry.SerialNumberID = unit.ID
+		entry.SerialNumber = *unit
+	}
+
+	return nil
+}
+
+// movementSerials returns entries naming the units moved by a movement
+func movementSerials(tx *gorm.DB, movementID *uint) ([]models.StockMovementSerial, error) {
+	if movementID == nil {
+		return nil, nil
+	}
+
+	var moved []models.StockMovementSerial
+	if err := tx.Preload("SerialNumber").Where("movement_id = ?", *movementID).Order("id ASC").Find(&moved).Error; err != nil {
+		return nil, err
+	}
+
+	serials := make([]models.StockMovementSerial, len(moved))
+	for i, entry := range moved {
+		serials[i] = models.StockMovementSerial{SerialNumber: models.SerialNumber{Serial: entry.SerialNumber.Serial}}
+	}
+	return serials, nil
+}
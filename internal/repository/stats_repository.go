@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+)
+
+type StatsRepository interface {
+	CategoryStats() ([]models.CategoryStats, error)
+	StockValues() ([]models.CategoryValue, error)
+	RetailValues() ([]models.CurrencyValue, error)
+}
+
+type statsRepository struct {
+	db *gorm.DB
+}
+
+func NewStatsRepository(db *gorm.DB) StatsRepository {
+	return &statsRepository{db: db}
+}
+
+// stockHolding matches products that hold stock of their own, joined as b
+// by stockHolders
+const stockHolding = "b.bundle_id IS NULL"
+
+// stockHolders selects products, with bundles joined as b. Variant parents
+// are left out: their stock is held by their variants, so they would only
+// count as products out of stock.
+func (r *statsRepository) stockHolders() *gorm.DB {
+	return r.db.Table("products p").
+		Joins("LEFT JOIN (SELECT DISTINCT bundle_id FROM bundle_components) b ON b.bundle_id = p.id").
+		Where("p.deleted_at IS NULL").
+		Where("NOT EXISTS (SELECT 1 FROM products v WHERE v.parent_id = p.id AND v.deleted_at IS NULL)")
+}
+
+// CategoryStats returns the product counts and stock totals per primary
+// category, ordered by category, without their stock values. Variant
+// parents are not counted and stock figures leave out bundles; low stock
+// follows the stock alert rule of stock above zero and at or below a set
+// reorder point.
+func (r *statsRepository) CategoryStats() ([]models.CategoryStats, error) {
+	var stats []models.CategoryStats
+	err := r.stockHolders().
+		Select("p.category_id, COALESCE(c.name, '') AS category_name, COUNT(*) AS products, " +
+			"COALESCE(SUM(p.stock) FILTER (WHERE " + stockHolding + "), 0) AS units, " +
+			"COUNT(*) FILTER (WHERE " + stockHolding + " AND p.stock <= 0) AS out_of_stock, " +
+			"COUNT(*) FILTER (WHERE " + stockHolding + " AND p.stock > 0 AND p.reorder_point > 0 AND p.stock <= p.reorder_point) AS low_stock").
+		Joins("LEFT JOIN categories c ON c.id = p.category_id AND c.deleted_at IS NULL").
+		Group("p.category_id, c.name").
+		Order("p.category_id ASC").
+		Scan(&stats).Error
+	if err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// StockValues returns the stock held at average cost per primary category
+// and product currency, rounded to the cent
+func (r *statsRepository) StockValues() ([]models.CategoryValue, error) {
+	var values []models.CategoryValue
+	err := r.stockHolders().
+		Select("p.category_id, p.currency, ROUND(SUM(p.stock * p.average_cost), 2) AS value").
+		Where(stockHolding + " AND p.stock > 0").
+		Group("p.category_id, p.currency").
+		Order("p.category_id ASC, p.currency ASC").
+		Scan(&values).Error
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// RetailValues returns the stock held at list price, per price currency
+func (r *statsRepository) RetailValues() ([]models.CurrencyValue, error) {
+	var values []models.CurrencyValue
+	err := r.stockHolders().
+		Select("p.currency, ROUND(SUM(p.price * p.stock), 2) AS value").
+		Where(stockHolding + " AND p.stock > 0").
+		Group("p.currency").
+		Order("p.currency ASC").
+		Scan(&values).Error
+	if err != nil {
+		return nil, err
+	}
+	return values, nil
+}
@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrAlertNotFound = errors.New("alert not found")
+)
+
+type StockAlertRepository interface {
+	Create(alert *models.StockAlert) error
+	FindByID(id uint) (*models.StockAlert, error)
+	FindUnresolvedByProductID(productID uint) (*models.StockAlert, error)
+	Update(alert *models.StockAlert) error
+	List(productID *uint, alertType, status string, page, pageSize int) ([]models.StockAlert, int64, error)
+}
+
+type stockAlertRepository struct {
+	db *gorm.DB
+}
+
+func NewStockAlertRepository(db *gorm.DB) StockAlertRepository {
+	return &stockAlertRepository{db: db}
+}
+
+func (r *stockAlertRepository) Create(alert *models.StockAlert) error {
+	return r.db.Omit("Product").Create(alert).Error
+}
+
+func (r *stockAlertRepository) FindByID(id uint) (*models.StockAlert, error) {
+	var alert models.StockAlert
+	err := r.db.Preload("Product").First(&alert, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrAlertNotFound
+		}
+		return nil, err
+	}
+	return &alert, nil
+}
+
+// FindUnresolvedByProductID returns the open or acknowledged alert of a
+// product, or nil if there is none
+func (r *stockAlertRepository) FindUnresolvedByProductID(productID uint) (*models.StockAlert, error) {
+	var alert models.StockAlert
+	err := r.db.Preload("Product").Where("product_id = ? AND status <> ?", productID, models.AlertResolved).
+		Order("id DESC").First(&alert).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &alert, nil
+}
+
+func (r *stockAlertRepository) Update(alert *models.StockAlert) error {
+	return r.db.Omit("Product").Save(alert).Error
+}
+
+func (r *stockAlertRepository) List(productID *uint, alertType, status string, page, pageSize int) ([]models.StockAlert, int64, error) {
+	var alerts []models.StockAlert
+	var total int64
+
+	query := r.db.Model(&models.StockAlert{})
+
+	if productID != nil && *productID > 0 {
+		query = query.Where("product_id = ?", *productID)
+	}
+	if alertType != "" {
+		query = query.Where("type = ?", alertType)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	query.Count(&total)
+
+	offset := (page - 1) * pageSize
+	err := query.Preload("Product").Order("created_at DESC, id DESC").Offset(offset).Limit(pageSize).Find(&alerts).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return alerts, total, nil
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+)
+
+type StockAnalysisRepository interface {
+	Usage(from, to time.Time, currency string, categoryID *uint) ([]models.ProductUsage, error)
+	DeadStock(since time.Time, categoryID *uint) ([]models.DeadStockLine, error)
+	Snapshot(at time.Time, categoryID *uint) ([]models.SnapshotLine, error)
+}
+
+type stockAnalysisRepository struct {
+	db *gorm.DB
+}
+
+func NewStockAnalysisRepository(db *gorm.DB) StockAnalysisRepository {
+	return &stockAnalysisRepository{db: db}
+}
+
+// Usage returns, per product holding stock of its own and costed in
+// currency, the time-weighted average stock over [from, to) and the stock
+// used up in it, both read from product history. Stock held before a
+// product's first history row counts as none.
+func (r *stockAnalysisRepository) Usage(from, to time.Time, currency string, categoryID *uint) ([]models.ProductUsage, error) {
+	seconds := to.Sub(from).Seconds()
+
+	// Each row's stock is held from its change, or the start of the window,
+	// until the next row replaced it, or the end of the window
+	usage := r.db.Table("(?) c", historyChanges(r.db, from, to, categoryID)).
+		Select("c.product_id, "+
+			"COALESCE(SUM(GREATEST(c.stock, 0) * EXTRACT(EPOCH FROM LEAST(COALESCE(c.next_at, @to), @to) - GREATEST(c.changed_at, @from))) "+
+			"FILTER (WHERE COALESCE(c.next_at, @to) > @from), 0) / @seconds AS average_stock, "+
+			"COALESCE(SUM(c.decrease) FILTER (WHERE c.changed_at >= @from AND @used), 0) AS usage",
+			map[string]interface{}{"from": from, "to": to, "seconds": seconds, "used": usedStock}).
+		Group("c.product_id")
+
+	query := r.db.Table("products p").
+		Select("p.id AS product_id, p.sku, p.name, p.category_id, COALESCE(cat.name, '') AS category_name, p.average_cost, "+
+			"COALESCE(u.average_stock, 0) AS average_stock, COALESCE(u.usage, 0) AS usage").
+		Joins("LEFT JOIN (?) u ON u.product_id = p.id", usage).
+		Joins("LEFT JOIN categories cat ON cat.id = p.category_id AND cat.deleted_at IS NULL").
+		Where("p.deleted_at IS NULL AND p.currency = ?", currency).
+		Where("NOT EXISTS (SELECT 1 FROM bundle_components bc WHERE bc.bundle_id = p.id)")
+
+	if categoryID != nil && *categoryID > 0 {
+		query = query.Where("p.category_id = ?", *categoryID)
+	}
+
+	var products []models.ProductUsage
+	if err := query.Order("p.id ASC").Scan(&products).Error; err != nil {
+		return nil, err
+	}
+	return products, nil
+}
+
+// DeadStock returns the products holding stock that have had no stock
+// movement since the given time, including those that never moved, by stock
+// value, highest first
+func (r *stockAnalysisRepository) DeadStock(since time.Time, categoryID *uint) ([]models.DeadStockLine, error) {
+	lastMovements := r.db.Model(&models.StockMovement{}).
+		Select("product_id, MAX(created_at) AS last_movement_at").
+		Group("product_id")
+
+	query := r.db.Table("products p").
+		Select("p.id AS product_id, p.sku, p.name, p.category_id, COALESCE(cat.name, '') AS category_name, "+
+			"p.stock, p.currency, p.average_cost, ROUND(p.stock * p.average_cost, 2) AS stock_value, lm.last_movement_at").
+		Joins("LEFT JOIN (?) lm ON lm.product_id = p.id", lastMovements).
+		Joins("LEFT JOIN categories cat ON cat.id = p.category_id AND cat.deleted_at IS NULL").
+		Where("p.deleted_at IS NULL AND p.stock > 0").
+		Where("lm.last_movement_at IS NULL OR lm.last_movement_at < ?", since)
+
+	if categoryID != nil && *categoryID > 0 {
+		query = query.Where("p.category_id = ?", *categoryID)
+	}
+
+	var lines []models.DeadStockLine
+	if err := query.Order("stock_value DESC, p.id ASC").Scan(&lines).Error; err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
+
+// Snapshot returns, per product that existed at the given time and holds
+// stock of its own, its latest history row at or before it, by product.
+// Bundles are left out since their stock is their components'. The row is
+// looked up per product
+// through the (product_id, changed_at) index, so the cost grows with the
+// number of products rather than the length of their history.
+func (r *stockAnalysisRepository) Snapshot(at time.Time, categoryID *uint) ([]models.SnapshotLine, error) {
+	query := r.db.Table("products p").
+		Select("p.id AS product_id, p.sku, p.name, p.category_id, COALESCE(cat.name, '') AS category_name, "+
+			"h.stock, h.price, h.currency, h.changed_at").
+		Joins("JOIN LATERAL (SELECT stock, price, currency, changed_at FROM product_history "+
+			"WHERE product_id = p.id AND changed_at <= ? ORDER BY changed_at DESC, id DESC LIMIT 1) h ON true", at).
+		Joins("LEFT JOIN categories cat ON cat.id = p.category_id AND cat.deleted_at IS NULL").
+		Where("p.deleted_at IS NULL OR p.deleted_at > ?", at).
+		Where("NOT EXISTS (SELECT 1 FROM bundle_components bc WHERE bc.bundle_id = p.id)")
+
+	if categoryID != nil && *categoryID > 0 {
+		query = query.Where("p.category_id = ?", *categoryID)
+	}
+
+	var lines []models.SnapshotLine
+	if err := query.Order("p.id ASC").Scan(&lines).Error; err != nil {
+		return nil, err
+	}
+	return lines, nil
+}
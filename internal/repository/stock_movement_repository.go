@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrInsufficientStock = errors.New("insufficient stock")
+	ErrQuantityPrecision = errors.New("quantity has more decimal places than the product allows")
+)
+
+type StockMovementRepository interface {
+	Create(movement *models.StockMovement) error
+	FindByProductID(productID uint, movementType string, start, end *time.Time, page, pageSize int) ([]models.StockMovement, int64, error)
+}
+
+type stockMovementRepository struct {
+	db *gorm.DB
+}
+
+func NewStockMovementRepository(db *gorm.DB) StockMovementRepository {
+	return &stockMovementRepository{db: db}
+}
+
+// Create applies the movement to stock and records it in a single transaction
+func (r *stockMovementRepository) Create(movement *models.StockMovement) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return applyStockMovement(tx, movement)
+	})
+}
+
+func (r *stockMovementRepository) FindByProductID(productID uint, movementType string, start, end *time.Time, page, pageSize int) ([]models.StockMovement, int64, error) {
+	var movements []models.StockMovement
+	var total int64
+
+	query := r.db.Model(&models.StockMovement{}).Where("product_id = ?", productID)
+
+	if movementType != "" {
+		query = query.Where("type = ?", movementType)
+	}
+
+	// Apply date filters
+	if start != nil {
+		query = query.Where("created_at >= ?", *start)
+	}
+	if end != nil {
+		// Add 1 day to end date to include the entire day
+		endPlusDay := end.Add(24 * time.Hour)
+		query = query.Where("created_at < ?", endPlusDay)
+	}
+
+	query.Count(&total)
+
+	offset := (page - 1) * pageSize
+	err := query.Preload("Lots.Lot").Preload("Serials.SerialNumber").Preload("Components").Order("created_at DESC, id DESC").Offset(offset).Limit(pageSize).Find(&movements).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return movements, total, nil
+}
+
+// applyStockMovement increments the warehouse quantity by the movement's
+// delta, refreshes the product's aggregate stock and writes the movement and
+// a history row. Movements of bundles are applied to their components. The
+// movement's cost is recorded by applyMovementCost. Sales, damage, transfer
+// dispatches and consumption fail with ErrInsufficientAvailability if they
+// would leave less stock than is reserved, in total or at the warehouse. It
+// must run inside a transaction.
+func applyStockMovement(tx *gorm.DB, movement *models.StockMovement) error {
+	product, err := lockProduct(tx, movement.ProductID)
+	if err != nil {
+		return err
+	}
+	if !product.ValidQuantity(movement.Quantity) {
+		return ErrQuantityPrecision
+	}
+
+	// Movements without a location apply to the default warehouse
+	movement.WarehouseID, err = resolveWarehouseID(tx, movement.WarehouseID)
+	if err != nil {
+		return err
+	}
+
+	// Bundles hold no stock; their components move instead
+	components, err := bundleComponents(tx, movement.ProductID)
+	if err != nil {
+		return err
+	}
+	if len(components) > 0 {
+		return applyBundleMovement(tx, product, components, movement)
+	}
+
+	now := time.Now()
+	row := models.ProductStock{
+		ProductID:   movement.ProductID,
+		WarehouseID: movement.WarehouseID,
+		Quantity:    movement.Quantity,
+		UpdatedAt:   now,
+	}
+	err = tx.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "product_id"}, {Name: "warehouse_id"}},
+		DoUpdates: clause.Assignments(map[string]interface{}{
+			"quantity":   gorm.Expr("product_stocks.quantity + EXCLUDED.quantity"),
+			"updated_at": now,
+		}),
+	}).Omit("Warehouse").Create(&row).Error
+	if err != nil {
+		return err
+	}
+
+	var quantity models.Quantity
+	err = tx.Model(&models.ProductStock{}).Select("quantity").
+		Where("product_id = ? AND warehouse_id = ?", movement.ProductID, movement.WarehouseID).
+		Scan(&quantity).Error
+	if err != nil {
+		return err
+	}
+	if quantity < 0 {
+		return ErrInsufficientStock
+	}
+	if movement.Type.Direction() < 0 {
+		held, err := heldAtWarehouse(tx, movement.ProductID, movement.WarehouseID)
+		if err != nil {
+			return err
+		}
+		if quantity < held {
+			return ErrInsufficientAvailability
+		}
+	}
+
+	if product.LotTracked {
+		if err := applyLotMovement(tx, movement); err != nil {
+			return err
+		}
+	} else {
+		movement.Lots = nil
+	}
+	if product.Serialized {
+		if err := applySerialMovement(tx, movement); err != nil {
+			return err
+		}
+	} else {
+		movement.Serials = nil
+	}
+
+	if err := syncProductStock(tx, movement.ProductID); err != nil {
+		return err
+	}
+	if err := tx.Model(&models.Product{}).Select("stock").Where("id = ?", movement.ProductID).Scan(&movement.StockAfter).Error; err != nil {
+		return err
+	}
+	// Outbound movements cannot take stock that reservations hold;
+	// adjustments still can, since they correct stock to what is on hand
+	if movement.Type.Direction() < 0 && movement.StockAfter < product.Reserved {
+		return ErrInsufficientAvailability
+	}
+	if err := applyMovementCost(tx, product, movement); err != nil {
+		return err
+	}
+
+	movement.CreatedAt = now
+	if err := tx.Omit(clause.Associations).Create(movement).Error; err != nil {
+		return err
+	}
+	if len(movement.Lots) > 0 {
+		for i := range movement.Lots {
+			movement.Lots[i].MovementID = movement.ID
+		}
+		if err := tx.Omit("Lot").Create(&movement.Lots).Error; err != nil {
+			return err
+		}
+	}
+	if len(movement.Serials) > 0 {
+		for i := range movement.Serials {
+			movement.Serials[i].MovementID = movement.ID
+		}
+		if err := tx.Omit("SerialNumber").Create(&movement.Serials).Error; err != nil {
+			return err
+		}
+	}
+
+	history := &models.ProductHistory{
+		ProductID:  movement.ProductID,
+		Price:      product.Price,
+		Currency:   product.Currency,
+		Stock:      movement.StockAfter,
+		MovementID: &movement.ID,
+		ChangedAt:  now,
+	}
+	return tx.Omit("Product").Create(history).Error
+}
+
+// applyMovementCost records the cost of a movement. Inbound movements other
+// than transfers carry a unit cost per base unit, the product's average cost
+// if none was given, and fold it into the average cost weighted by the stock
+// held before the movement. Transfers and outbound movements leave the
+// average unchanged. Every movement keeps the average cost after it, so
+// stock can be valued at any point in the ledger.
+func applyMovementCost(tx *gorm.DB, product *models.Product, movement *models.StockMovement) error {
+	if movement.Quantity <= 0 || movement.Type == models.MovementTransferIn {
+		movement.UnitCost = nil
+		movement.AverageCost = product.AverageCost
+		return nil
+	}
+
+	unitCost := product.AverageCost
+	if movement.UnitCost != nil {
+		unitCost = *movement.UnitCost
+	}
+	movement.UnitCost = &unitCost
+
+	held := max(product.Stock, 0)
+	average := models.WeightedCost(product.AverageCost, held, unitCost, movement.Quantity)
+	if average != product.AverageCost {
+		if err := tx.Model(product).UpdateColumn("average_cost", average).Error; err != nil {
+			return err
+		}
+		product.AverageCost = average
+	}
+	movement.AverageCost = average
+	return nil
+}
@@ -0,0 +1,334 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrStocktakeNotFound   = errors.New("stocktake not found")
+	ErrStocktakeStatus     = errors.New("stocktake status does not allow this action")
+	ErrStocktakeEmpty      = errors.New("stocktake scope has no products to count")
+	ErrStocktakeProduct    = errors.New("product is not part of the stocktake")
+	ErrStocktakeNotCounted = errors.New("stocktake has no counted lines")
+)
+
+type StocktakeRepository interface {
+	Create(stocktake *models.Stocktake) error
+	FindByID(id uint) (*models.Stocktake, error)
+	List(warehouseID, categoryID *uint, status string, page, pageSize int) ([]models.Stocktake, int64, error)
+	SubmitCounts(id uint, counts map[uint]models.Quantity, userID *uint) (*models.Stocktake, error)
+	Approve(id uint, userID *uint) (*models.Stocktake, []models.StockMovement, error)
+	Reject(id uint, reason string, userID *uint) (*models.Stocktake, error)
+}
+
+type stocktakeRepository struct {
+	db *gorm.DB
+}
+
+func NewStocktakeRepository(db *gorm.DB) StocktakeRepository {
+	return &stocktakeRepository{db: db}
+}
+
+// Create opens a stocktake with a line for every product in its scope and
+// assigns its number. A category covers the products in it as their primary
+// or an additional category; a warehouse on its own covers the products
+// stocked there. A category without a warehouse is counted at the default
+// warehouse, since stock is counted and adjusted one location at a time.
+// Bundles and serialized products are left out, since their stock is not
+// adjusted by quantity alone.
+func (r *stocktakeRepository) Create(stocktake *models.Stocktake) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		warehouseID, err := stocktakeWarehouseID(tx, stocktake)
+		if err != nil {
+			return err
+		}
+		stocktake.WarehouseID = &warehouseID
+
+		if stocktake.CategoryID != nil {
+			var count int64
+			tx.Model(&models.Category{}).Where("id = ?", *stocktake.CategoryID).Count(&count)
+			if count == 0 {
+				return ErrCategoryNotFound
+			}
+		}
+
+		query := tx.Model(&models.Product{}).
+			Where("serialized = ?", false).
+			Where("NOT EXISTS (SELECT 1 FROM bundle_components bc WHERE bc.bundle_id = products.id)")
+		if stocktake.CategoryID != nil {
+			query = query.Where("category_id = ? OR id IN (SELECT product_id FROM product_categories WHERE category_id = ?)",
+				*stocktake.CategoryID, *stocktake.CategoryID)
+		} else {
+			query = query.Where("id IN (SELECT product_id FROM product_stocks WHERE warehouse_id = ?)", *stocktake.WarehouseID)
+		}
+
+		var productIDs []uint
+		if err := query.Order("id ASC").Pluck("id", &productIDs).Error; err != nil {
+			return err
+		}
+		if len(productIDs) == 0 {
+			return ErrStocktakeEmpty
+		}
+
+		stocktake.Status = models.StocktakeOpen
+		if err := tx.Omit(clause.Associations).Create(stocktake).Error; err != nil {
+			return err
+		}
+
+		lines := make([]models.StocktakeLine, len(productIDs))
+		for i, productID := range productIDs {
+			lines[i] = models.StocktakeLine{StocktakeID: stocktake.ID, ProductID: productID}
+		}
+		if err := tx.Omit("Product").CreateInBatches(&lines, 500).Error; err != nil {
+			return err
+		}
+		stocktake.Lines = lines
+
+		stocktake.Number = fmt.Sprintf("ST-%06d", stocktake.ID)
+		return tx.Model(stocktake).UpdateColumn("number", stocktake.Number).Error
+	})
+}
+
+func (r *stocktakeRepository) FindByID(id uint) (*models.Stocktake, error) {
+	return findStocktake(r.db, id)
+}
+
+func (r *stocktakeRepository) List(warehouseID, categoryID *uint, status string, page, pageSize int) ([]models.Stocktake, int64, error) {
+	var stocktakes []models.Stocktake
+	var total int64
+
+	query := r.db.Model(&models.Stocktake{})
+
+	if warehouseID != nil && *warehouseID > 0 {
+		query = query.Where("warehouse_id = ?", *warehouseID)
+	}
+	if categoryID != nil && *categoryID > 0 {
+		query = query.Where("category_id = ?", *categoryID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	query.Count(&total)
+
+	offset := (page - 1) * pageSize
+	err := preloadStocktake(query).Order("id DESC").Offset(offset).Limit(pageSize).Find(&stocktakes).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return stocktakes, total, nil
+}
+
+// SubmitCounts records counted quantities, in base units, against the lines
+// of an open stocktake. Each count stores the stock expected at that moment
+// so the variance reflects movements made before the count but not after.
+func (r *stocktakeRepository) SubmitCounts(id uint, counts map[uint]models.Quantity, userID *uint) (*models.Stocktake, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		stocktake, err := lockStocktake(tx, id, models.StocktakeOpen)
+		if err != nil {
+			return err
+		}
+
+		var lines []models.StocktakeLine
+		if err := tx.Where("stocktake_id = ?", id).Order("product_id ASC").Find(&lines).Error; err != nil {
+			return err
+		}
+		inScope := make(map[uint]bool, len(lines))
+		for _, line := range lines {
+			inScope[line.ProductID] = true
+		}
+		for productID := range counts {
+			if !inScope[productID] {
+				return ErrStocktakeProduct
+			}
+		}
+
+		now := time.Now()
+		for _, line := range lines {
+			counted, ok := counts[line.ProductID]
+			if !ok {
+				continue
+			}
+			expected, err := stocktakeExpected(tx, stocktake, line.ProductID)
+			if err != nil {
+				return err
+			}
+			err = tx.Model(&line).Updates(map[string]interface{}{
+				"counted":    counted,
+				"expected":   expected,
+				"counted_by": userID,
+				"counted_at": now,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return tx.Model(stocktake).UpdateColumn("updated_at", now).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return findStocktake(r.db, id)
+}
+
+// Approve posts the variance of every counted line as an adjustment
+// movement, each writing its own history row, and closes the stocktake in
+// one transaction. Adjustments go to the stocktake's warehouse. Lines that
+// were not counted are left unchanged.
+func (r *stocktakeRepository) Approve(id uint, userID *uint) (*models.Stocktake, []models.StockMovement, error) {
+	var movements []models.StockMovement
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		stocktake, err := lockStocktake(tx, id, models.StocktakeOpen)
+		if err != nil {
+			return err
+		}
+
+		// Lines are applied in product order so product rows are locked consistently
+		var lines []models.StocktakeLine
+		err = tx.Where("stocktake_id = ? AND counted IS NOT NULL", id).Order("product_id ASC").Find(&lines).Error
+		if err != nil {
+			return err
+		}
+		if len(lines) == 0 {
+			return ErrStocktakeNotCounted
+		}
+
+		warehouseID, err := stocktakeWarehouseID(tx, stocktake)
+		if err != nil {
+			return err
+		}
+
+		for _, line := range lines {
+			variance := line.Variance()
+			if variance == 0 {
+				continue
+			}
+
+			movement := models.StockMovement{
+				ProductID:   line.ProductID,
+				WarehouseID: warehouseID,
+				Type:        models.MovementAdjustment,
+				Quantity:    variance,
+				Reason:      "Stocktake variance",
+				Reference:   stocktake.Number,
+				UserID:      userID,
+			}
+			if err := applyStockMovement(tx, &movement); err != nil {
+				return err
+			}
+			if err := tx.Model(&line).UpdateColumn("movement_id", movement.ID).Error; err != nil {
+				return err
+			}
+			movements = append(movements, movement)
+		}
+
+		now := time.Now()
+		return tx.Model(stocktake).Updates(map[string]interface{}{
+			"status":      models.StocktakeApproved,
+			"reviewed_by": userID,
+			"reviewed_at": now,
+			"updated_at":  now,
+		}).Error
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stocktake, err := findStocktake(r.db, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return stocktake, movements, nil
+}
+
+// Reject closes an open stocktake without changing stock
+func (r *stocktakeRepository) Reject(id uint, reason string, userID *uint) (*models.Stocktake, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		stocktake, err := lockStocktake(tx, id, models.StocktakeOpen)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		return tx.Model(stocktake).Updates(map[string]interface{}{
+			"status":           models.StocktakeRejected,
+			"rejection_reason": reason,
+			"reviewed_by":      userID,
+			"reviewed_at":      now,
+			"updated_at":       now,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return findStocktake(r.db, id)
+}
+
+// stocktakeExpected returns the stock a count is compared with, the
+// quantity at the stocktake's warehouse
+func stocktakeExpected(tx *gorm.DB, stocktake *models.Stocktake, productID uint) (models.Quantity, error) {
+	warehouseID, err := stocktakeWarehouseID(tx, stocktake)
+	if err != nil {
+		return 0, err
+	}
+	return locationQuantity(tx, productID, warehouseID)
+}
+
+// stocktakeWarehouseID returns the warehouse a stocktake counts, the
+// default warehouse for one without a warehouse
+func stocktakeWarehouseID(tx *gorm.DB, stocktake *models.Stocktake) (uint, error) {
+	var warehouseID uint
+	if stocktake.WarehouseID != nil {
+		warehouseID = *stocktake.WarehouseID
+	}
+	return resolveWarehouseID(tx, warehouseID)
+}
+
+// preloadStocktake adds the associations shown in stocktake responses
+func preloadStocktake(db *gorm.DB) *gorm.DB {
+	return db.Preload("Warehouse").Preload("Category").Preload("Lines", func(db *gorm.DB) *gorm.DB {
+		return db.Order("product_id ASC")
+	}).Preload("Lines.Product")
+}
+
+// findStocktake loads a stocktake with its warehouse, category and lines
+func findStocktake(db *gorm.DB, id uint) (*models.Stocktake, error) {
+	var stocktake models.Stocktake
+	err := preloadStocktake(db).First(&stocktake, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStocktakeNotFound
+		}
+		return nil, err
+	}
+	return &stocktake, nil
+}
+
+// lockStocktake loads a stocktake row with FOR UPDATE and checks that it is
+// in one of the given statuses
+func lockStocktake(tx *gorm.DB, id uint, statuses ...models.StocktakeStatus) (*models.Stocktake, error) {
+	var stocktake models.Stocktake
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&stocktake, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrStocktakeNotFound
+		}
+		return nil, err
+	}
+	for _, status := range statuses {
+		if stocktake.Status == status {
+			return &stocktake, nil
+		}
+	}
+	return nil, ErrStocktakeStatus
+}
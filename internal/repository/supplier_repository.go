@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrSupplierNotFound      = errors.New("supplier not found")
+	ErrSupplierAlreadyExists = errors.New("supplier with this name already exists")
+	ErrSupplierHasOrders     = errors.New("supplier has purchase orders")
+)
+
+type SupplierRepository interface {
+	Create(supplier *models.Supplier) error
+	FindByID(id uint) (*models.Supplier, error)
+	Update(supplier *models.Supplier) error
+	Delete(id uint) error
+	List(page, pageSize int, search string) ([]models.Supplier, int64, error)
+}
+
+type supplierRepository struct {
+	db *gorm.DB
+}
+
+func NewSupplierRepository(db *gorm.DB) SupplierRepository {
+	return &supplierRepository{db: db}
+}
+
+func (r *supplierRepository) Create(supplier *models.Supplier) error {
+	// Check if supplier with same name already exists
+	var count int64
+	r.db.Model(&models.Supplier{}).Where("name = ?", supplier.Name).Count(&count)
+	if count > 0 {
+		return ErrSupplierAlreadyExists
+	}
+
+	return r.db.Create(supplier).Error
+}
+
+func (r *supplierRepository) FindByID(id uint) (*models.Supplier, error) {
+	var supplier models.Supplier
+	err := r.db.First(&supplier, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSupplierNotFound
+		}
+		return nil, err
+	}
+	return &supplier, nil
+}
+
+func (r *supplierRepository) Update(supplier *models.Supplier) error {
+	// Check if another supplier with same name exists
+	var count int64
+	r.db.Model(&models.Supplier{}).Where("name = ? AND id != ?", supplier.Name, supplier.ID).Count(&count)
+	if count > 0 {
+		return ErrSupplierAlreadyExists
+	}
+
+	return r.db.Save(supplier).Error
+}
+
+func (r *supplierRepository) Delete(id uint) error {
+	// Keep suppliers that purchase orders still refer to
+	var count int64
+	if err := r.db.Model(&models.PurchaseOrder{}).Where("supplier_id = ?", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrSupplierHasOrders
+	}
+
+	result := r.db.Delete(&models.Supplier{}, id)
+	if result.RowsAffected == 0 {
+		return ErrSupplierNotFound
+	}
+	return result.Error
+}
+
+func (r *supplierRepository) List(page, pageSize int, search string) ([]models.Supplier, int64, error) {
+	var suppliers []models.Supplier
+	var total int64
+
+	query := r.db.Model(&models.Supplier{})
+
+	if search != "" {
+		searchPattern := "%" + search + "%"
+		query = query.Where("LOWER(name) LIKE LOWER(?) OR LOWER(contact_name) LIKE LOWER(?)", searchPattern, searchPattern)
+	}
+
+	query.Count(&total)
+
+	offset := (page - 1) * pageSize
+	err := query.Offset(offset).Limit(pageSize).Order("id ASC").Find(&suppliers).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return suppliers, total, nil
+}
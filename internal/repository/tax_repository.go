@@ -0,0 +1,177 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrTaxClassNotFound      = errors.New("tax class not found")
+	ErrTaxClassAlreadyExists = errors.New("tax class with this code already exists")
+	ErrTaxClassInUse         = errors.New("tax class is assigned to products")
+	ErrDuplicateTaxRate      = errors.New("a tax class may only have one rate per jurisdiction")
+)
+
+type TaxRepository interface {
+	Create(class *models.TaxClass) error
+	FindByID(id uint) (*models.TaxClass, error)
+	Update(class *models.TaxClass) error
+	Delete(id uint) error
+	List(page, pageSize int, search string) ([]models.TaxClass, int64, error)
+	SetRates(id uint, rates []models.TaxRateRequest) error
+	FindRates(jurisdiction string, classIDs []uint) (map[uint]models.TaxRate, error)
+}
+
+type taxRepository struct {
+	db *gorm.DB
+}
+
+func NewTaxRepository(db *gorm.DB) TaxRepository {
+	return &taxRepository{db: db}
+}
+
+func (r *taxRepository) Create(class *models.TaxClass) error {
+	// Check if a tax class with the same code already exists
+	var count int64
+	r.db.Model(&models.TaxClass{}).Where("code = ?", class.Code).Count(&count)
+	if count > 0 {
+		return ErrTaxClassAlreadyExists
+	}
+
+	return r.db.Omit("Rates").Create(class).Error
+}
+
+// FindByID returns a tax class with its rates by jurisdiction
+func (r *taxRepository) FindByID(id uint) (*models.TaxClass, error) {
+	var class models.TaxClass
+	err := r.db.Preload("Rates", func(db *gorm.DB) *gorm.DB {
+		return db.Order("jurisdiction ASC")
+	}).First(&class, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTaxClassNotFound
+		}
+		return nil, err
+	}
+	return &class, nil
+}
+
+func (r *taxRepository) Update(class *models.TaxClass) error {
+	// Check if another tax class with the same code exists
+	var count int64
+	r.db.Model(&models.TaxClass{}).Where("code = ? AND id != ?", class.Code, class.ID).Count(&count)
+	if count > 0 {
+		return ErrTaxClassAlreadyExists
+	}
+
+	return r.db.Omit("Rates").Save(class).Error
+}
+
+// Delete removes a tax class together with its rates. Products must be moved
+// off the class first.
+func (r *taxRepository) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var products int64
+		tx.Model(&models.Product{}).Where("tax_class_id = ?", id).Count(&products)
+		if products > 0 {
+			return ErrTaxClassInUse
+		}
+
+		result := tx.Delete(&models.TaxClass{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return ErrTaxClassNotFound
+		}
+		return tx.Where("tax_class_id = ?", id).Delete(&models.TaxRate{}).Error
+	})
+}
+
+func (r *taxRepository) List(page, pageSize int, search string) ([]models.TaxClass, int64, error) {
+	var classes []models.TaxClass
+	var total int64
+
+	query := r.db.Model(&models.TaxClass{})
+
+	if search != "" {
+		searchPattern := "%" + search + "%"
+		query = query.Where("LOWER(code) LIKE LOWER(?) OR LOWER(name) LIKE LOWER(?)", searchPattern, searchPattern)
+	}
+
+	query.Count(&total)
+
+	offset := (page - 1) * pageSize
+	err := query.Offset(offset).Limit(pageSize).Order("id ASC").Find(&classes).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return classes, total, nil
+}
+
+// SetRates replaces all rates of a tax class. Each jurisdiction may have one
+// rate.
+func (r *taxRepository) SetRates(id uint, rates []models.TaxRateRequest) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var class models.TaxClass
+		if err := tx.First(&class, id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrTaxClassNotFound
+			}
+			return err
+		}
+
+		seen := make(map[string]bool, len(rates))
+		rows := make([]models.TaxRate, len(rates))
+		for i, rate := range rates {
+			jurisdiction := models.NormalizeJurisdiction(rate.Jurisdiction)
+			if seen[jurisdiction] {
+				return ErrDuplicateTaxRate
+			}
+			seen[jurisdiction] = true
+			rows[i] = models.TaxRate{
+				TaxClassID:   id,
+				Jurisdiction: jurisdiction,
+				Name:         rate.Name,
+				Rate:         rate.Rate,
+			}
+		}
+
+		if err := tx.Where("tax_class_id = ?", id).Delete(&models.TaxRate{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Model(&class).UpdateColumn("updated_at", time.Now()).Error; err != nil {
+			return err
+		}
+		if len(rows) == 0 {
+			return nil
+		}
+		return tx.Omit("TaxClass").Create(&rows).Error
+	})
+}
+
+// FindRates returns, per tax class, its rate in the jurisdiction with the
+// class loaded. Classes without a rate there are left out.
+func (r *taxRepository) FindRates(jurisdiction string, classIDs []uint) (map[uint]models.TaxRate, error) {
+	rates := make(map[uint]models.TaxRate, len(classIDs))
+	if len(classIDs) == 0 {
+		return rates, nil
+	}
+
+	var rows []models.TaxRate
+	err := r.db.Preload("TaxClass").
+		Where("jurisdiction = ? AND tax_class_id IN ?", models.NormalizeJurisdiction(jurisdiction), classIDs).
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		rates[row.TaxClassID] = row
+	}
+	return rates, nil
+}
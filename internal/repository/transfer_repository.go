@@ -0,0 +1,325 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrTransferNotFound      = errors.New("transfer not found")
+	ErrTransferStatus        = errors.New("transfer status does not allow this action")
+	ErrTransferSameWarehouse = errors.New("source and destination warehouse must differ")
+	ErrTransferBundle        = errors.New("bundles cannot be transferred")
+)
+
+type TransferRepository interface {
+	Create(transfer *models.Transfer) error
+	FindByID(id uint) (*models.Transfer, error)
+	List(warehouseID *uint, status string, page, pageSize int) ([]models.Transfer, int64, error)
+	Dispatch(id uint, serials map[uint]models.SerialInput, userID *uint) (*models.Transfer, []models.StockMovement, error)
+	Receive(id uint, userID *uint) (*models.Transfer, []models.StockMovement, error)
+	Cancel(id uint) (*models.Transfer, error)
+}
+
+type transferRepository struct {
+	db *gorm.DB
+}
+
+func NewTransferRepository(db *gorm.DB) TransferRepository {
+	return &transferRepository{db: db}
+}
+
+// Create inserts a draft transfer with its lines and assigns its number
+func (r *transferRepository) Create(transfer *models.Transfer) error {
+	if transfer.SourceWarehouseID == transfer.DestinationWarehouseID {
+		return ErrTransferSameWarehouse
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for _, id := range []uint{transfer.SourceWarehouseID, transfer.DestinationWarehouseID} {
+			if _, err := resolveWarehouseID(tx, id); err != nil {
+				return err
+			}
+		}
+
+		productIDs := make([]uint, len(transfer.Lines))
+		for i, line := range transfer.Lines {
+			productIDs[i] = line.ProductID
+		}
+		if err := verifyProductsExist(tx, productIDs); err != nil {
+			return err
+		}
+
+		// Bundles hold no stock of their own; their components move instead
+		var bundles int64
+		tx.Model(&models.BundleComponent{}).Where("bundle_id IN ?", productIDs).Count(&bundles)
+		if bundles > 0 {
+			return ErrTransferBundle
+		}
+
+		transfer.Status = models.TransferDraft
+		if err := tx.Omit(clause.Associations).Create(transfer).Error; err != nil {
+			return err
+		}
+
+		for i := range transfer.Lines {
+			transfer.Lines[i].TransferID = transfer.ID
+		}
+		if err := tx.Omit("Product").Create(&transfer.Lines).Error; err != nil {
+			return err
+		}
+
+		transfer.Number = fmt.Sprintf("TR-%06d", transfer.ID)
+		return tx.Model(transfer).UpdateColumn("number", transfer.Number).Error
+	})
+}
+
+func (r *transferRepository) FindByID(id uint) (*models.Transfer, error) {
+	return findTransfer(r.db, id)
+}
+
+func (r *transferRepository) List(warehouseID *uint, status string, page, pageSize int) ([]models.Transfer, int64, error) {
+	var transfers []models.Transfer
+	var total int64
+
+	query := r.db.Model(&models.Transfer{})
+
+	if warehouseID != nil && *warehouseID > 0 {
+		query = query.Where("source_warehouse_id = ? OR destination_warehouse_id = ?", *warehouseID, *warehouseID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	query.Count(&total)
+
+	offset := (page - 1) * pageSize
+	err := preloadTransfer(query).Order("id DESC").Offset(offset).Limit(pageSize).Find(&transfers).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return transfers, total, nil
+}
+
+// Dispatch takes the transfer's stock out of the source warehouse with one
+// transfer_out movement per line. Stock held by reservations cannot leave.
+// serials holds the units sent for lines of serialized products.
+func (r *transferRepository) Dispatch(id uint, serials map[uint]models.SerialInput, userID *uint) (*models.Transfer, []models.StockMovement, error) {
+	var movements []models.StockMovement
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		transfer, err := lockTransfer(tx, id, models.TransferDraft)
+		if err != nil {
+			return err
+		}
+
+		lines, err := transferLines(tx, id)
+		if err != nil {
+			return err
+		}
+
+		for _, line := range lines {
+			movement := models.StockMovement{
+				ProductID:   line.ProductID,
+				WarehouseID: transfer.SourceWarehouseID,
+				Type:        models.MovementTransferOut,
+				Quantity:    -line.Quantity,
+				Reason:      "Transfer dispatched",
+				Reference:   transfer.Number,
+				UserID:      userID,
+				Serials:     serials[line.ID].Entries(),
+			}
+			if err := applyStockMovement(tx, &movement); err != nil {
+				return err
+			}
+			if err := tx.Model(&line).UpdateColumn("dispatch_movement_id", movement.ID).Error; err != nil {
+				return err
+			}
+
+			movements = append(movements, movement)
+		}
+
+		return setTransferStatus(tx, transfer, models.TransferInTransit)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transfer, err := findTransfer(r.db, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return transfer, movements, nil
+}
+
+// Receive books an in-transit transfer into the destination warehouse with
+// one transfer_in movement per line, into the same lots and as the same
+// units that were dispatched
+func (r *transferRepository) Receive(id uint, userID *uint) (*models.Transfer, []models.StockMovement, error) {
+	var movements []models.StockMovement
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		transfer, err := lockTransfer(tx, id, models.TransferInTransit)
+		if err != nil {
+			return err
+		}
+
+		lines, err := transferLines(tx, id)
+		if err != nil {
+			return err
+		}
+
+		for _, line := range lines {
+			lots, err := dispatchedLots(tx, line.DispatchMovementID)
+			if err != nil {
+				return err
+			}
+			serials, err := movementSerials(tx, line.DispatchMovementID)
+			if err != nil {
+				return err
+			}
+
+			movement := models.StockMovement{
+				ProductID:   line.ProductID,
+				WarehouseID: transfer.DestinationWarehouseID,
+				Type:        models.MovementTransferIn,
+				Quantity:    line.Quantity,
+				Reason:      "Transfer received",
+				Reference:   transfer.Number,
+				UserID:      userID,
+				Lots:        lots,
+				Serials:     serials,
+			}
+			if err := applyStockMovement(tx, &movement); err != nil {
+				return err
+			}
+			movements = append(movements, movement)
+		}
+
+		return setTransferStatus(tx, transfer, models.TransferReceived)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	transfer, err := findTransfer(r.db, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return transfer, movements, nil
+}
+
+// Cancel cancels a transfer that has not been dispatched
+func (r *transferRepository) Cancel(id uint) (*models.Transfer, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		transfer, err := lockTransfer(tx, id, models.TransferDraft)
+		if err != nil {
+			return err
+		}
+		return setTransferStatus(tx, transfer, models.TransferCancelled)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return findTransfer(r.db, id)
+}
+
+// dispatchedLots returns inbound lot entries mirroring the lots taken by a
+// dispatch movement
+func dispatchedLots(tx *gorm.DB, movementID *uint) ([]models.StockMovementLot, error) {
+	if movementID == nil {
+		return nil, nil
+	}
+
+	var taken []models.StockMovementLot
+	if err := tx.Preload("Lot").Where("movement_id = ?", *movementID).Order("id ASC").Find(&taken).Error; err != nil {
+		return nil, err
+	}
+
+	lots := make([]models.StockMovementLot, len(taken))
+	for i, entry := range taken {
+		lots[i] = models.StockMovementLot{
+			Lot: models.Lot{
+				LotNumber:      entry.Lot.LotNumber,
+				ManufacturedAt: entry.Lot.ManufacturedAt,
+				ExpiresAt:      entry.Lot.ExpiresAt,
+			},
+			Quantity: -entry.Quantity,
+		}
+	}
+	return lots, nil
+}
+
+// preloadTransfer adds the associations shown in transfer responses
+func preloadTransfer(db *gorm.DB) *gorm.DB {
+	return db.Preload("SourceWarehouse").Preload("DestinationWarehouse").Preload("Lines", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).Preload("Lines.Product")
+}
+
+// findTransfer loads a transfer with its warehouses and lines
+func findTransfer(db *gorm.DB, id uint) (*models.Transfer, error) {
+	var transfer models.Transfer
+	err := preloadTransfer(db).First(&transfer, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTransferNotFound
+		}
+		return nil, err
+	}
+	return &transfer, nil
+}
+
+// lockTransfer loads a transfer row with FOR UPDATE and checks that it is in
+// the given status
+func lockTransfer(tx *gorm.DB, id uint, status models.TransferStatus) (*models.Transfer, error) {
+	var transfer models.Transfer
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&transfer, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrTransferNotFound
+		}
+		return nil, err
+	}
+	if transfer.Status != status {
+		return nil, ErrTransferStatus
+	}
+	return &transfer, nil
+}
+
+// transferLines returns a transfer's lines sorted by product so that product
+// rows are always locked in the same order
+func transferLines(tx *gorm.DB, transferID uint) ([]models.TransferLine, error) {
+	var lines []models.TransferLine
+	if err := tx.Where("transfer_id = ?", transferID).Order("id ASC").Find(&lines).Error; err != nil {
+		return nil, err
+	}
+	sort.SliceStable(lines, func(i, j int) bool {
+		return lines[i].ProductID < lines[j].ProductID
+	})
+	return lines, nil
+}
+
+// setTransferStatus moves a locked transfer to status and stamps the matching time
+func setTransferStatus(tx *gorm.DB, transfer *models.Transfer, status models.TransferStatus) error {
+	now := time.Now()
+	updates := map[string]interface{}{
+		"status":     status,
+		"updated_at": now,
+	}
+	switch status {
+	case models.TransferInTransit:
+		updates["dispatched_at"] = now
+	case models.TransferReceived:
+		updates["received_at"] = now
+	case models.TransferCancelled:
+		updates["cancelled_at"] = now
+	}
+	return tx.Model(transfer).Updates(updates).Error
+}
@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+)
+
+type ValuationRepository interface {
+	Valuation(method string, from, asOf time.Time, categoryID *uint) ([]models.ProductValuation, error)
+}
+
+type valuationRepository struct {
+	db *gorm.DB
+}
+
+func NewValuationRepository(db *gorm.DB) ValuationRepository {
+	return &valuationRepository{db: db}
+}
+
+// valuationMovement is a ledger entry as read for valuation
+type valuationMovement struct {
+	ProductID    uint
+	CategoryID   uint
+	CategoryName string
+	Currency     string
+	Type         models.MovementType
+	Quantity     models.Quantity
+	UnitCost     *models.Cost
+	AverageCost  models.Cost
+	CreatedAt    time.Time
+}
+
+// costLayer is stock received at one unit cost, consumed first in first out
+type costLayer struct {
+	quantity models.Quantity
+	unitCost models.Cost
+}
+
+// Valuation replays the ledger up to asOf and returns, per product, the stock
+// held at asOf, its cost and the cost of goods sold from from up to asOf,
+// under the given costing method, in the product's currency. Transfers move stock between warehouses
+// without changing what it cost, so they are left out and stock in transit is
+// still valued. Bundle movements are valued through their components.
+func (r *valuationRepository) Valuation(method string, from, asOf time.Time, categoryID *uint) ([]models.ProductValuation, error) {
+	query := r.db.Table("stock_movements m").
+		Select("m.product_id, p.category_id, COALESCE(c.name, '') AS category_name, p.currency, m.type, m.quantity, m.unit_cost, m.average_cost, m.created_at").
+		Joins("JOIN products p ON p.id = m.product_id AND p.deleted_at IS NULL").
+		Joins("LEFT JOIN categories c ON c.id = p.category_id AND c.deleted_at IS NULL").
+		Where("m.created_at <= ?", asOf).
+		Where("m.type NOT IN ?", []models.MovementType{models.MovementTransferIn, models.MovementTransferOut}).
+		Where("NOT EXISTS (SELECT 1 FROM stock_movements b WHERE b.parent_id = m.id)")
+
+	if categoryID != nil && *categoryID > 0 {
+		query = query.Where("p.category_id = ?", *categoryID)
+	}
+
+	// The ledger is read in one pass and replayed a product at a time, so
+	// only one product's movements are held in memory
+	rows, err := query.Order("m.product_id ASC, m.created_at ASC, m.id ASC").Rows()
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	value := valueFIFO
+	if method == models.CostingAverage {
+		value = valueAverage
+	}
+
+	var valuations []models.ProductValuation
+	var movements []valuationMovement
+	for rows.Next() {
+		var m valuationMovement
+		if err := r.db.ScanRows(rows, &m); err != nil {
+			return nil, err
+		}
+		if len(movements) > 0 && movements[0].ProductID != m.ProductID {
+			valuations = append(valuations, value(movements, from))
+			movements = movements[:0]
+		}
+		movements = append(movements, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(movements) > 0 {
+		valuations = append(valuations, value(movements, from))
+	}
+
+	return valuations, nil
+}
+
+// valueAverage values a product's movements at the average cost recorded on
+// each of them. Sales are costed at the average at the time of sale and
+// returns at the cost they came back in at.
+func valueAverage(movements []valuationMovement, from time.Time) models.ProductValuation {
+	valuation := newProductValuation(movements[0])
+	var costOfGoodsSold models.Cost
+	for _, m := range movements {
+		valuation.Quantity += m.Quantity
+		if m.CreatedAt.Before(from) {
+			continue
+		}
+		switch m.Type {
+		case models.MovementSale:
+			costOfGoodsSold -= m.AverageCost.Mul(m.Quantity)
+		case models.MovementReturn:
+			costOfGoodsSold -= inboundCost(m).Mul(m.Quantity)
+		}
+	}
+
+	last := movements[len(movements)-1]
+	valuation.Value = last.AverageCost.Mul(valuation.Quantity).Amount()
+	valuation.CostOfGoodsSold = costOfGoodsSold.Amount()
+	return valuation
+}
+
+// valueFIFO values a product's movements by keeping the stock received as
+// cost layers, oldest first. Outbound movements consume the oldest layers and
+// sales are costed at the layers they consumed. Stock taken out beyond what
+// was received is costed at the average cost and is made good by the next
+// stock received.
+func valueFIFO(movements []valuationMovement, from time.Time) models.ProductValuation {
+	valuation := newProductValuation(movements[0])
+	var layers []costLayer
+	var deficit models.Quantity
+	var value, deficitCost, costOfGoodsSold models.Cost
+
+	for _, m := range movements {
+		inPeriod := !m.CreatedAt.Before(from)
+
+		if m.Quantity > 0 {
+			unitCost := inboundCost(m)
+			if inPeriod && m.Type == models.MovementReturn {
+				costOfGoodsSold -= unitCost.Mul(m.Quantity)
+			}
+			quantity := m.Quantity
+			if deficit > 0 {
+				covered := min(deficit, quantity)
+				deficit -= covered
+				quantity -= covered
+			}
+			if quantity > 0 {
+				layers = append(layers, costLayer{quantity: quantity, unitCost: unitCost})
+			}
+			continue
+		}
+
+		remaining := -m.Quantity
+		var cost models.Cost
+		for remaining > 0 && len(layers) > 0 {
+			taken := min(remaining, layers[0].quantity)
+			cost += layers[0].unitCost.Mul(taken)
+			remaining -= taken
+			layers[0].quantity -= taken
+			if layers[0].quantity <= 0 {
+				layers = layers[1:]
+			}
+		}
+		if remaining > 0 {
+			cost += m.AverageCost.Mul(remaining)
+			deficit += remaining
+			deficitCost = m.AverageCost
+		}
+		if inPeriod && m.Type == models.MovementSale {
+			costOfGoodsSold += cost
+		}
+	}
+
+	for _, layer := range layers {
+		valuation.Quantity += layer.quantity
+		value += layer.unitCost.Mul(layer.quantity)
+	}
+	if deficit > 0 {
+		valuation.Quantity = -deficit
+		value = -deficitCost.Mul(deficit)
+	}
+
+	valuation.Value = value.Amount()
+	valuation.CostOfGoodsSold = costOfGoodsSold.Amount()
+	return valuation
+}
+
+// inboundCost returns the unit cost stock came in at, the average cost for
+// movements recorded before costs were tracked
+func inboundCost(m valuationMovement) models.Cost {
+	if m.UnitCost != nil {
+		return *m.UnitCost
+	}
+	return m.AverageCost
+}
+
+func newProductValuation(m valuationMovement) models.ProductValuation {
+	return models.ProductValuation{
+		ProductID:    m.ProductID,
+		CategoryID:   m.CategoryID,
+		CategoryName: m.CategoryName,
+		Currency:     m.Currency,
+	}
+}
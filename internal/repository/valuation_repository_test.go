@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+)
+
+// costed returns a pointer to a unit cost for building test movements.
+// Costs are in ten-thousandths and values in hundredths of a currency unit;
+// quantities are in thousandths of a unit.
+func costed(cost models.Cost) *models.Cost {
+	return &cost
+}
+
+func TestValueFIFO(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 12, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name      string
+		movements []valuationMovement
+		from      time.Time
+		want      models.ProductValuation
+	}{
+		{
+			name: "sale consumes the oldest layer first",
+			movements: []valuationMovement{
+				{Type: models.MovementReceipt, Quantity: 10000, UnitCost: costed(20000), AverageCost: 20000, CreatedAt: day(1)},
+				{Type: models.MovementReceipt, Quantity: 10000, UnitCost: costed(30000), AverageCost: 25000, CreatedAt: day(2)},
+				{Type: models.MovementSale, Quantity: -15000, AverageCost: 25000, CreatedAt: day(3)},
+			},
+			from: day(1),
+			want: models.ProductValuation{Quantity: 5000, Value: 1500, CostOfGoodsSold: 3500},
+		},
+		{
+			name: "sales before the period are not costed",
+			movements: []valuationMovement{
+				{Type: models.MovementReceipt, Quantity: 10000, UnitCost: costed(20000), AverageCost: 20000, CreatedAt: day(1)},
+				{Type: models.MovementSale, Quantity: -4000, AverageCost: 20000, CreatedAt: day(2)},
+			},
+			from: day(3),
+			want: models.ProductValuation{Quantity: 6000, Value: 1200},
+		},
+		{
+			name: "returns come back at the average cost without a unit cost",
+			movements: []valuationMovement{
+				{Type: models.MovementReceipt, Quantity: 4000, UnitCost: costed(20000), AverageCost: 20000, CreatedAt: day(1)},
+				{Type: models.MovementSale, Quantity: -4000, AverageCost: 20000, CreatedAt: day(2)},
+				{Type: models.MovementReturn, Quantity: 1000, AverageCost: 25000, CreatedAt: day(3)},
+			},
+			from: day(1),
+			want: models.ProductValuation{Quantity: 1000, Value: 250, CostOfGoodsSold: 550},
+		},
+		{
+			name: "stock taken out beyond receipts is made good by the next receipt",
+			movements: []valuationMovement{
+				{Type: models.MovementSale, Quantity: -5000, AverageCost: 20000, CreatedAt: day(1)},
+				{Type: models.MovementReceipt, Quantity: 3000, UnitCost: costed(40000), AverageCost: 40000, CreatedAt: day(2)},
+			},
+			from: day(1),
+			want: models.ProductValuation{Quantity: -2000, Value: -400, CostOfGoodsSold: 1000},
+		},
+		{
+			name: "adjustments move layers without costing sales",
+			movements: []valuationMovement{
+				{Type: models.MovementReceipt, Quantity: 3000, UnitCost: costed(11000), AverageCost: 11000, CreatedAt: day(1)},
+				{Type: models.MovementAdjustment, Quantity: -1000, AverageCost: 11000, CreatedAt: day(2)},
+			},
+			from: day(1),
+			want: models.ProductValuation{Quantity: 2000, Value: 220},
+		},
+		{
+			name: "value is rounded to the cent once per product",
+			movements: []valuationMovement{
+				{Type: models.MovementReceipt, Quantity: 3000, UnitCost: costed(3333), AverageCost: 3333, CreatedAt: day(1)},
+			},
+			from: day(1),
+			want: models.ProductValuation{Quantity: 3000, Value: 100},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := valueFIFO(tt.movements, tt.from); got != tt.want {
+				t.Errorf("valueFIFO() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValueAverage(t *testing.T) {
+	day := func(d int) time.Time { return time.Date(2026, 3, d, 12, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name      string
+		movements []valuationMovement
+		from      time.Time
+		want      models.ProductValuation
+	}{
+		{
+			name: "sales are costed at the average at the time of sale",
+			movements: []valuationMovement{
+				{Type: models.MovementReceipt, Quantity: 10000, UnitCost: costed(20000), AverageCost: 20000, CreatedAt: day(1)},
+				{Type: models.MovementReceipt, Quantity: 10000, UnitCost: costed(30000), AverageCost: 25000, CreatedAt: day(2)},
+				{Type: models.MovementSale, Quantity: -4000, AverageCost: 25000, CreatedAt: day(3)},
+			},
+			from: day(1),
+			want: models.ProductValuation{Quantity: 16000, Value: 4000, CostOfGoodsSold: 1000},
+		},
+		{
+			name: "returns are credited at the cost they came back in at",
+			movements: []valuationMovement{
+				{Type: models.MovementReceipt, Quantity: 10000, UnitCost: costed(25000), AverageCost: 25000, CreatedAt: day(1)},
+				{Type: models.MovementSale, Quantity: -4000, AverageCost: 25000, CreatedAt: day(2)},
+				{Type: models.MovementReturn, Quantity: 1000, UnitCost: costed(20000), AverageCost: 24286, CreatedAt: day(3)},
+			},
+			from: day(1),
+			want: models.ProductValuation{Quantity: 7000, Value: 1700, CostOfGoodsSold: 800},
+		},
+		{
+			name: "sales before the period are not costed",
+			movements: []valuationMovement{
+				{Type: models.MovementReceipt, Quantity: 10000, UnitCost: costed(20000), AverageCost: 20000, CreatedAt: day(1)},
+				{Type: models.MovementSale, Quantity: -4000, AverageCost: 20000, CreatedAt: day(2)},
+			},
+			from: day(3),
+			want: models.ProductValuation{Quantity: 6000, Value: 1200},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := valueAverage(tt.movements, tt.from); got != tt.want {
+				t.Errorf("valueAverage() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
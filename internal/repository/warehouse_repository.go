@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+)
+
+var (
+	ErrWarehouseNotFound      = errors.New("warehouse not found")
+	ErrWarehouseAlreadyExists = errors.New("warehouse with this code already exists")
+	ErrWarehouseHasStock      = errors.New("warehouse still holds stock")
+	ErrWarehouseIsDefault     = errors.New("default warehouse cannot be deleted")
+	ErrNoDefaultWarehouse     = errors.New("no default warehouse configured")
+)
+
+type WarehouseRepository interface {
+	Create(warehouse *models.Warehouse) error
+	FindByID(id uint) (*models.Warehouse, error)
+	FindDefault() (*models.Warehouse, error)
+	Update(warehouse *models.Warehouse) error
+	Delete(id uint) error
+	List(page, pageSize int) ([]models.Warehouse, int64, error)
+	ListStock(warehouseID uint, page, pageSize int) ([]models.ProductStock, int64, error)
+}
+
+type warehouseRepository struct {
+	db *gorm.DB
+}
+
+func NewWarehouseRepository(db *gorm.DB) WarehouseRepository {
+	return &warehouseRepository{db: db}
+}
+
+func (r *warehouseRepository) Create(warehouse *models.Warehouse) error {
+	// Check if warehouse with same code already exists
+	var count int64
+	r.db.Model(&models.Warehouse{}).Where("code = ?", warehouse.Code).Count(&count)
+	if count > 0 {
+		return ErrWarehouseAlreadyExists
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// Only one warehouse can be the default
+		if warehouse.IsDefault {
+			if err := tx.Model(&models.Warehouse{}).Where("is_default = ?", true).Update("is_default", false).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Create(warehouse).Error
+	})
+}
+
+func (r *warehouseRepository) FindByID(id uint) (*models.Warehouse, error) {
+	var warehouse models.Warehouse
+	err := r.db.First(&warehouse, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWarehouseNotFound
+		}
+		return nil, err
+	}
+	return &warehouse, nil
+}
+
+func (r *warehouseRepository) FindDefault() (*models.Warehouse, error) {
+	return findDefaultWarehouse(r.db)
+}
+
+func (r *warehouseRepository) Update(warehouse *models.Warehouse) error {
+	// Check if another warehouse has the same code
+	var count int64
+	r.db.Model(&models.Warehouse{}).Where("code = ? AND id != ?", warehouse.Code, warehouse.ID).Count(&count)
+	if count > 0 {
+		return ErrWarehouseAlreadyExists
+	}
+
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if warehouse.IsDefault {
+			if err := tx.Model(&models.Warehouse{}).Where("is_default = ? AND id != ?", true, warehouse.ID).Update("is_default", false).Error; err != nil {
+				return err
+			}
+		}
+		return tx.Save(warehouse).Error
+	})
+}
+
+func (r *warehouseRepository) Delete(id uint) error {
+	warehouse, err := r.FindByID(id)
+	if err != nil {
+		return err
+	}
+	if warehouse.IsDefault {
+		return ErrWarehouseIsDefault
+	}
+
+	// Refuse to delete a location that still holds stock
+	var count int64
+	if err := r.db.Model(&models.ProductStock{}).Where("warehouse_id = ? AND quantity <> 0", id).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrWarehouseHasStock
+	}
+
+	result := r.db.Delete(&models.Warehouse{}, id)
+	if result.RowsAffected == 0 {
+		return ErrWarehouseNotFound
+	}
+	return result.Error
+}
+
+func (r *warehouseRepository) List(page, pageSize int) ([]models.Warehouse, int64, error) {
+	var warehouses []models.Warehouse
+	var total int64
+
+	r.db.Model(&models.Warehouse{}).Count(&total)
+
+	offset := (page - 1) * pageSize
+	err := r.db.Offset(offset).Limit(pageSize).Order("id ASC").Find(&warehouses).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return warehouses, total, nil
+}
+
+func (r *warehouseRepository) ListStock(warehouseID uint, page, pageSize int) ([]models.ProductStock, int64, error) {
+	var stocks []models.ProductStock
+	var total int64
+
+	query := r.db.Model(&models.ProductStock{}).Where("warehouse_id = ?", warehouseID)
+
+	query.Count(&total)
+
+	offset := (page - 1) * pageSize
+	err := query.Preload("Warehouse").Offset(offset).Limit(pageSize).Order("product_id ASC").Find(&stocks).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return stocks, total, nil
+}
+
+// findDefaultWarehouse returns the warehouse flagged as default using the given connection
+func findDefaultWarehouse(db *gorm.DB) (*models.Warehouse, error) {
+	var warehouse models.Warehouse
+	err := db.Where("is_default = ?", true).First(&warehouse).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNoDefaultWarehouse
+		}
+		return nil, err
+	}
+	return &warehouse, nil
+}
+
+// resolveWarehouseID returns the default warehouse's ID for 0 and otherwise
+// checks that the warehouse exists
+func resolveWarehouseID(db *gorm.DB, id uint) (uint, error) {
+	if id == 0 {
+		warehouse, err := findDefaultWarehouse(db)
+		if err != nil {
+			return 0, err
+		}
+		return warehouse.ID, nil
+	}
+
+	var count int64
+	if err := db.Model(&models.Warehouse{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return 0, err
+	}
+	if count == 0 {
+		return 0, ErrWarehouseNotFound
+	}
+	return id, nil
+}
@@ -0,0 +1,377 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var (
+	ErrWorkOrderNotFound        = errors.New("work order not found")
+	ErrWorkOrderStatus          = errors.New("work order status does not allow this action")
+	ErrWorkOrderComponent       = errors.New("a product cannot be a component of itself")
+	ErrWorkOrderBundle          = errors.New("bundles cannot be assembled by work orders")
+	ErrWorkOrderEmptyCompletion = errors.New("completion must build or scrap at least one unit")
+	ErrOverCompletion           = errors.New("completion exceeds the outstanding quantity")
+)
+
+type WorkOrderRepository interface {
+	Create(order *models.WorkOrder) error
+	FindByID(id uint) (*models.WorkOrder, error)
+	List(productID *uint, status string, page, pageSize int) ([]models.WorkOrder, int64, error)
+	Complete(id uint, req *models.CompleteWorkOrderRequest, userID *uint) (*models.WorkOrder, []models.StockMovement, error)
+	Cancel(id uint) (*models.WorkOrder, error)
+}
+
+type workOrderRepository struct {
+	db *gorm.DB
+}
+
+func NewWorkOrderRepository(db *gorm.DB) WorkOrderRepository {
+	return &workOrderRepository{db: db}
+}
+
+// Create inserts a draft work order with its components and assigns its
+// number. Repeated components are merged into one line each.
+func (r *workOrderRepository) Create(order *models.WorkOrder) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		warehouseID, err := resolveWarehouseID(tx, order.WarehouseID)
+		if err != nil {
+			return err
+		}
+		order.WarehouseID = warehouseID
+
+		var components []models.WorkOrderComponent
+		index := make(map[uint]int, len(order.Components))
+		productIDs := []uint{order.ProductID}
+		for _, component := range order.Components {
+			if component.ProductID == order.ProductID {
+				return ErrWorkOrderComponent
+			}
+			if i, seen := index[component.ProductID]; seen {
+				components[i].QuantityPerUnit += component.QuantityPerUnit
+				continue
+			}
+			index[component.ProductID] = len(components)
+			components = append(components, component)
+			productIDs = append(productIDs, component.ProductID)
+		}
+		if err := verifyProductsExist(tx, productIDs); err != nil {
+			return err
+		}
+		if err := checkAssemblable(tx, order.ProductID); err != nil {
+			return err
+		}
+
+		// The quantity to build must fit the finished product's precision,
+		// and the components must be costed in its currency
+		var product models.Product
+		if err := tx.Select("id", "quantity_precision", "currency").First(&product, order.ProductID).Error; err != nil {
+			return err
+		}
+		if !product.ValidQuantity(order.Quantity) {
+			return ErrQuantityPrecision
+		}
+		if err := verifyCostCurrency(tx, product.Currency, productIDs[1:]); err != nil {
+			return err
+		}
+
+		order.Status = models.WorkOrderDraft
+		if err := tx.Omit(clause.Associations).Create(order).Error; err != nil {
+			return err
+		}
+
+		for i := range components {
+			components[i].WorkOrderID = order.ID
+		}
+		if err := tx.Omit("Product").Create(&components).Error; err != nil {
+			return err
+		}
+		order.Components = components
+
+		order.Number = fmt.Sprintf("WO-%06d", order.ID)
+		return tx.Model(order).UpdateColumn("number", order.Number).Error
+	})
+}
+
+func (r *workOrderRepository) FindByID(id uint) (*models.WorkOrder, error) {
+	return findWorkOrder(r.db, id)
+}
+
+func (r *workOrderRepository) List(productID *uint, status string, page, pageSize int) ([]models.WorkOrder, int64, error) {
+	var orders []models.WorkOrder
+	var total int64
+
+	query := r.db.Model(&models.WorkOrder{})
+
+	if productID != nil && *productID > 0 {
+		query = query.Where("product_id = ?", *productID)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	query.Count(&total)
+
+	offset := (page - 1) * pageSize
+	err := preloadWorkOrder(query).Order("id DESC").Offset(offset).Limit(pageSize).Find(&orders).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return orders, total, nil
+}
+
+// Complete books built and scrapped units against a work order in one
+// transaction. Every component is consumed for all of those units with a
+// consumption movement, and the built units are added to the finished
+// product with a production movement; each movement writes its own history
+// row. The built units are costed at the components consumed, scrap
+// included. Components held by reservations cannot be consumed. The order is
+// completed once nothing is outstanding.
+func (r *workOrderRepository) Complete(id uint, req *models.CompleteWorkOrderRequest, userID *uint) (*models.WorkOrder, []models.StockMovement, error) {
+	var movements []models.StockMovement
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		order, err := lockWorkOrder(tx, id, models.WorkOrderDraft, models.WorkOrderInProgress)
+		if err != nil {
+			return err
+		}
+
+		units := req.Quantity + req.Scrapped
+		if units == 0 {
+			return ErrWorkOrderEmptyCompletion
+		}
+		if units > order.Outstanding() {
+			return ErrOverCompletion
+		}
+
+		// The product may have been made a bundle since the order was created
+		if err := checkAssemblable(tx, order.ProductID); err != nil {
+			return err
+		}
+
+		var components []models.WorkOrderComponent
+		if err := tx.Where("work_order_id = ?", id).Order("id ASC").Find(&components).Error; err != nil {
+			return err
+		}
+
+		reason := "Work order consumption"
+		if req.Scrapped > 0 {
+			reason = fmt.Sprintf("Work order consumption (%s scrapped)", req.Scrapped)
+		}
+		serials := models.SerialsByLine(req.Components)
+
+		pending := make([]models.StockMovement, 0, len(components)+1)
+		for _, component := range components {
+			pending = append(pending, models.StockMovement{
+				ProductID:   component.ProductID,
+				WarehouseID: order.WarehouseID,
+				Type:        models.MovementConsumption,
+				Quantity:    -units.Mul(component.QuantityPerUnit),
+				Reason:      reason,
+				Reference:   order.Number,
+				UserID:      userID,
+				Serials:     serials[component.ID].Entries(),
+			})
+		}
+		if req.Quantity > 0 {
+			if err := verifyComponentCurrency(tx, order.ProductID, components); err != nil {
+				return err
+			}
+			unitCost, err := productionCost(tx, components, units, req.Quantity)
+			if err != nil {
+				return err
+			}
+			pending = append(pending, models.StockMovement{
+				ProductID:   order.ProductID,
+				WarehouseID: order.WarehouseID,
+				Type:        models.MovementProduction,
+				Quantity:    req.Quantity,
+				UnitCost:    &unitCost,
+				Reason:      "Work order production",
+				Reference:   order.Number,
+				UserID:      userID,
+				Lots:        req.LotInput.Entries(req.Quantity),
+				Serials:     req.SerialInput.Entries(),
+			})
+		}
+
+		// Apply in product order so product rows are locked consistently
+		sort.SliceStable(pending, func(i, j int) bool {
+			return pending[i].ProductID < pending[j].ProductID
+		})
+
+		var productionID *uint
+		for i := range pending {
+			movement := &pending[i]
+			if err := applyStockMovement(tx, movement); err != nil {
+				return err
+			}
+
+			if movement.Type == models.MovementProduction {
+				productionID = &movement.ID
+			}
+		}
+		movements = pending
+
+		for _, component := range components {
+			err := tx.Model(&component).UpdateColumn("quantity_consumed", gorm.Expr("quantity_consumed + ?", units.Mul(component.QuantityPerUnit))).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		completion := &models.WorkOrderCompletion{
+			WorkOrderID: order.ID,
+			Quantity:    req.Quantity,
+			Scrapped:    req.Scrapped,
+			MovementID:  productionID,
+			UserID:      userID,
+		}
+		if err := tx.Create(completion).Error; err != nil {
+			return err
+		}
+
+		order.QuantityCompleted += req.Quantity
+		order.QuantityScrapped += req.Scrapped
+
+		now := time.Now()
+		updates := map[string]interface{}{
+			"quantity_completed": order.QuantityCompleted,
+			"quantity_scrapped":  order.QuantityScrapped,
+			"status":             models.WorkOrderInProgress,
+			"updated_at":         now,
+		}
+		if order.StartedAt == nil {
+			updates["started_at"] = now
+		}
+		if order.Outstanding() == 0 {
+			updates["status"] = models.WorkOrderCompleted
+			updates["completed_at"] = now
+		}
+		return tx.Model(order).Updates(updates).Error
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	order, err := findWorkOrder(r.db, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return order, movements, nil
+}
+
+// Cancel cancels a work order that is not completed. Components already
+// consumed stay consumed along with the units built from them.
+func (r *workOrderRepository) Cancel(id uint) (*models.WorkOrder, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		order, err := lockWorkOrder(tx, id, models.WorkOrderDraft, models.WorkOrderInProgress)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		return tx.Model(order).Updates(map[string]interface{}{
+			"status":       models.WorkOrderCancelled,
+			"cancelled_at": now,
+			"updated_at":   now,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return findWorkOrder(r.db, id)
+}
+
+// checkAssemblable rejects bundles as the finished product of a work order,
+// since their stock follows their components
+func checkAssemblable(tx *gorm.DB, productID uint) error {
+	var count int64
+	if err := tx.Model(&models.BundleComponent{}).Where("bundle_id = ?", productID).Count(&count).Error; err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrWorkOrderBundle
+	}
+	return nil
+}
+
+// preloadWorkOrder adds the associations shown in work order responses
+func preloadWorkOrder(db *gorm.DB) *gorm.DB {
+	return db.Preload("Product").Preload("Warehouse").Preload("Components", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	}).Preload("Components.Product").Preload("Completions", func(db *gorm.DB) *gorm.DB {
+		return db.Order("id ASC")
+	})
+}
+
+// findWorkOrder loads a work order with its product, warehouse, components
+// and completions
+func findWorkOrder(db *gorm.DB, id uint) (*models.WorkOrder, error) {
+	var order models.WorkOrder
+	err := preloadWorkOrder(db).First(&order, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWorkOrderNotFound
+		}
+		return nil, err
+	}
+	return &order, nil
+}
+
+// lockWorkOrder loads a work order row with FOR UPDATE and checks that it is
+// in one of the given statuses
+func lockWorkOrder(tx *gorm.DB, id uint, statuses ...models.WorkOrderStatus) (*models.WorkOrder, error) {
+	var order models.WorkOrder
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&order, id).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrWorkOrderNotFound
+		}
+		return nil, err
+	}
+	for _, status := range statuses {
+		if order.Status == status {
+			return &order, nil
+		}
+	}
+	return nil, ErrWorkOrderStatus
+}
+
+// verifyComponentCurrency checks that the components are still costed in
+// the finished product's currency, since their average costs are added up
+// into its unit cost
+func verifyComponentCurrency(tx *gorm.DB, productID uint, components []models.WorkOrderComponent) error {
+	var currency string
+	if err := tx.Model(&models.Product{}).Select("currency").Where("id = ?", productID).Scan(&currency).Error; err != nil {
+		return err
+	}
+	componentIDs := make([]uint, len(components))
+	for i, component := range components {
+		componentIDs[i] = component.ProductID
+	}
+	return verifyCostCurrency(tx, currency, componentIDs)
+}
+
+// productionCost returns the cost of each built unit: the components
+// consumed for all units, built and scrapped, at their average cost, spread
+// over the built units
+func productionCost(tx *gorm.DB, components []models.WorkOrderComponent, units, built models.Quantity) (models.Cost, error) {
+	var total models.Cost
+	for _, component := range components {
+		var averageCost models.Cost
+		err := tx.Model(&models.Product{}).Select("average_cost").Where("id = ?", component.ProductID).Scan(&averageCost).Error
+		if err != nil {
+			return 0, err
+		}
+		total += averageCost.Mul(units.Mul(component.QuantityPerUnit))
+	}
+	return total.Div(built), nil
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/pkg/websocket"
+)
+
+var (
+	ErrAlertNotOpen  = errors.New("alert is not open")
+	ErrAlertResolved = errors.New("alert is already resolved")
+)
+
+type AlertService interface {
+	Evaluate(product *models.Product)
+	GetByID(id uint) (*models.StockAlert, error)
+	List(productID *uint, alertType, status string, page, pageSize int) ([]models.StockAlert, int64, error)
+	Acknowledge(id, userID uint) (*models.StockAlert, error)
+	Resolve(id uint) (*models.StockAlert, error)
+}
+
+type alertService struct {
+	alertRepo repository.StockAlertRepository
+	wsHub     *websocket.Hub
+}
+
+func NewAlertService(alertRepo repository.StockAlertRepository, wsHub *websocket.Hub) AlertService {
+	return &alertService{
+		alertRepo: alertRepo,
+		wsHub:     wsHub,
+	}
+}
+
+// Evaluate compares a product's stock with its reorder point, opening a
+// low or out-of-stock alert when needed and resolving alerts once stock
+// recovers. Products without a reorder point are not alerted on, so new
+// products and variant parents at zero stay quiet. Failures are logged so
+// they never fail the stock change itself.
+func (s *alertService) Evaluate(product *models.Product) {
+	// A bundle's stock is what its components make up
+	stock := product.Stock
+	if product.IsBundle() {
+		stock, _ = product.BundleAvailability()
+	}
+
+	var level models.AlertType
+	switch {
+	case product.ReorderPoint <= 0:
+	case stock <= 0:
+		level = models.AlertOutOfStock
+	case stock <= product.ReorderPoint:
+		level = models.AlertLowStock
+	}
+
+	current, err := s.alertRepo.FindUnresolvedByProductID(product.ID)
+	if err != nil {
+		log.Printf("Error loading alerts for product %d: %v", product.ID, err)
+		return
+	}
+
+	// Nothing changed: keep the existing alert (or lack of one)
+	if current != nil && current.Type == level {
+		return
+	}
+
+	now := time.Now()
+	if current != nil {
+		current.Status = models.AlertResolved
+		current.ResolvedAt = &now
+		if err := s.alertRepo.Update(current); err != nil {
+			log.Printf("Error resolving alert %d: %v", current.ID, err)
+			return
+		}
+		s.resolved(current)
+	}
+
+	if level == "" {
+		return
+	}
+
+	alert := &models.StockAlert{
+		ProductID:       product.ID,
+		Type:            level,
+		Status:          models.AlertOpen,
+		Stock:           stock,
+		ReorderPoint:    product.ReorderPoint,
+		ReorderQuantity: product.ReorderQuantity,
+	}
+	if err := s.alertRepo.Create(alert); err != nil {
+		log.Printf("Error creating alert for product %d: %v", product.ID, err)
+		return
+	}
+	alert.Product = *product
+
+	// Broadcast WebSocket event
+	if s.wsHub != nil {
+		event := websocket.EventStockLow
+		if level == models.AlertOutOfStock {
+			event = websocket.EventStockOut
+		}
+		s.wsHub.BroadcastMessage(event, alert.ToResponse())
+	}
+}
+
+func (s *alertService) GetByID(id uint) (*models.StockAlert, error) {
+	return s.alertRepo.FindByID(id)
+}
+
+func (s *alertService) List(productID *uint, alertType, status string, page, pageSize int) ([]models.StockAlert, int64, error) {
+	return s.alertRepo.List(productID, alertType, status, page, pageSize)
+}
+
+func (s *alertService) Acknowledge(id, userID uint) (*models.StockAlert, error) {
+	alert, err := s.alertRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if alert.Status != models.AlertOpen {
+		return nil, ErrAlertNotOpen
+	}
+
+	now := time.Now()
+	alert.Status = models.AlertAcknowledged
+	alert.AcknowledgedBy = userRef(userID)
+	alert.AcknowledgedAt = &now
+
+	if err := s.alertRepo.Update(alert); err != nil {
+		return nil, err
+	}
+
+	return alert, nil
+}
+
+func (s *alertService) Resolve(id uint) (*models.StockAlert, error) {
+	alert, err := s.alertRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if alert.Status == models.AlertResolved {
+		return nil, ErrAlertResolved
+	}
+
+	now := time.Now()
+	alert.Status = models.AlertResolved
+	alert.ResolvedAt = &now
+
+	if err := s.alertRepo.Update(alert); err != nil {
+		return nil, err
+	}
+	s.resolved(alert)
+
+	return alert, nil
+}
+
+// resolved broadcasts alert.resolved so clients can clear the stock.low or
+// stock.out state the alert raised
+func (s *alertService) resolved(alert *models.StockAlert) {
+	if s.wsHub != nil {
+		s.wsHub.BroadcastMessage(websocket.EventAlertResolved, alert.ToResponse())
+	}
+}
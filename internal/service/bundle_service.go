@@ -0,0 +1,52 @@
+package service
+
+import (
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/pkg/websocket"
+)
+
+type BundleService interface {
+	SetComponents(bundleID uint, req *models.SetBundleComponentsRequest) (*models.Product, error)
+}
+
+type bundleService struct {
+	bundleRepo   repository.BundleRepository
+	productRepo  repository.ProductRepository
+	alertService AlertService
+	wsHub        *websocket.Hub
+}
+
+func NewBundleService(bundleRepo repository.BundleRepository, productRepo repository.ProductRepository, alertService AlertService, wsHub *websocket.Hub) BundleService {
+	return &bundleService{
+		bundleRepo:   bundleRepo,
+		productRepo:  productRepo,
+		alertService: alertService,
+		wsHub:        wsHub,
+	}
+}
+
+// SetComponents replaces a product's bill of materials and returns the
+// product with the stock its new components make up
+func (s *bundleService) SetComponents(bundleID uint, req *models.SetBundleComponentsRequest) (*models.Product, error) {
+	if err := s.bundleRepo.SetComponents(bundleID, req.Components); err != nil {
+		return nil, err
+	}
+
+	product, err := s.productRepo.FindByID(bundleID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Broadcast WebSocket event
+	if s.wsHub != nil {
+		s.wsHub.BroadcastMessage(websocket.EventProductUpdated, product.ToResponse())
+	}
+
+	// The bundle's stock now follows different components
+	if s.alertService != nil {
+		s.alertService.Evaluate(product)
+	}
+
+	return product, nil
+}
@@ -0,0 +1,192 @@
+package service
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+)
+
+type ForecastService interface {
+	Replenishment(query *models.ReplenishmentQuery) (*models.ReplenishmentReport, error)
+}
+
+type forecastService struct {
+	forecastRepo repository.ForecastRepository
+	defaults     models.ReplenishmentParams
+}
+
+func NewForecastService(forecastRepo repository.ForecastRepository, defaults models.ReplenishmentParams) ForecastService {
+	return &forecastService{
+		forecastRepo: forecastRepo,
+		defaults:     defaults,
+	}
+}
+
+// Replenishment forecasts each product's daily demand from the stock it lost
+// over the last WindowDays full days and suggests what to order. A product
+// needs ordering when it has demand and its stock position, available stock
+// plus stock on order, is at or below its reorder level: demand over the
+// lead time plus safety stock. The suggestion brings the position up to the
+// reorder level plus CoverDays of demand, and is at least the product's
+// reorder quantity. Unless All is set, only products that need ordering are
+// listed. Settings the query leaves unset take their configured defaults.
+func (s *forecastService) Replenishment(query *models.ReplenishmentQuery) (*models.ReplenishmentReport, error) {
+	params := s.params(query)
+
+	location := params.Location
+	if location == nil {
+		location = time.UTC
+	}
+	now := time.Now().In(location)
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, location)
+	from := to.AddDate(0, 0, -params.WindowDays)
+
+	products, err := s.forecastRepo.Products(params.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	demand, err := s.forecastRepo.DailyDemand(from, to, location, params.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+	onOrder, err := s.forecastRepo.OnOrder(params.CategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Lay each product's demand out as one value per day of the window
+	series := make(map[uint][]float64)
+	totals := make(map[uint]models.Quantity)
+	for _, d := range demand {
+		day := int(math.Round(d.Day.Sub(from).Hours() / 24))
+		if day < 0 || day >= params.WindowDays {
+			continue
+		}
+		values, ok := series[d.ProductID]
+		if !ok {
+			values = make([]float64, params.WindowDays)
+			series[d.ProductID] = values
+		}
+		values[day] += d.Quantity.Float64()
+		totals[d.ProductID] += d.Quantity
+	}
+
+	report := &models.ReplenishmentReport{
+		Method:          params.Method,
+		From:            from,
+		To:              to,
+		WindowDays:      params.WindowDays,
+		LeadTimeDays:    params.LeadTimeDays,
+		SafetyStockDays: params.SafetyStockDays,
+		CoverDays:       params.CoverDays,
+		Lines:           []models.ReplenishmentLine{},
+	}
+	if params.Method == models.ForecastExponential {
+		report.Alpha = params.Alpha
+	}
+
+	for i := range products {
+		product := &products[i]
+		values := series[product.ID]
+
+		var daily float64
+		if values != nil {
+			if params.Method == models.ForecastExponential {
+				daily = exponentialSmoothing(values, params.Alpha)
+			} else {
+				daily = movingAverage(values)
+			}
+		}
+
+		line := models.ReplenishmentLine{
+			ProductID:      product.ID,
+			SKU:            product.SKU,
+			Name:           product.Name,
+			CategoryID:     product.CategoryID,
+			BaseUnit:       product.BaseUnit,
+			Available:      product.Available(),
+			OnOrder:        onOrder[product.ID],
+			Demand:         totals[product.ID],
+			DailyDemand:    models.QuantityFromFloat(daily),
+			LeadTimeDemand: models.QuantityFromFloat(daily * float64(params.LeadTimeDays)),
+			SafetyStock:    models.QuantityFromFloat(daily * float64(params.SafetyStockDays)),
+		}
+		line.Position = line.Available + line.OnOrder
+		line.ReorderLevel = line.LeadTimeDemand + line.SafetyStock
+
+		if daily > 0 {
+			cover := math.Round(line.Available.Float64()/daily*10) / 10
+			line.DaysOfCover = &cover
+
+			if line.Position <= line.ReorderLevel {
+				target := line.ReorderLevel.Float64() + daily*float64(params.CoverDays)
+				line.SuggestedQuantity = product.CeilQuantity(math.Max(target-line.Position.Float64(), product.ReorderQuantity.Float64()))
+			}
+		}
+
+		if line.SuggestedQuantity > 0 || query.All {
+			report.Lines = append(report.Lines, line)
+		}
+	}
+
+	// Most urgent first; products without demand last
+	sort.SliceStable(report.Lines, func(i, j int) bool {
+		a, b := report.Lines[i].DaysOfCover, report.Lines[j].DaysOfCover
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		return *a < *b
+	})
+
+	return report, nil
+}
+
+// params resolves the report settings from the query and the defaults
+func (s *forecastService) params(query *models.ReplenishmentQuery) models.ReplenishmentParams {
+	params := s.defaults
+	if query.Method != "" {
+		params.Method = query.Method
+	}
+	if query.WindowDays > 0 {
+		params.WindowDays = query.WindowDays
+	}
+	if query.Alpha > 0 {
+		params.Alpha = query.Alpha
+	}
+	if query.LeadTimeDays != nil {
+		params.LeadTimeDays = *query.LeadTimeDays
+	}
+	if query.SafetyStockDays != nil {
+		params.SafetyStockDays = *query.SafetyStockDays
+	}
+	if query.CoverDays != nil {
+		params.CoverDays = *query.CoverDays
+	}
+	if query.CategoryID > 0 {
+		params.CategoryID = &query.CategoryID
+	}
+	return params
+}
+
+// movingAverage forecasts daily demand as the mean over the window
+func movingAverage(values []float64) float64 {
+	var total float64
+	for _, v := range values {
+		total += v
+	}
+	return total / float64(len(values))
+}
+
+// exponentialSmoothing forecasts daily demand by simple exponential
+// smoothing, weighting recent days by alpha. The level starts at the window
+// mean so a quiet first day does not drag the forecast down.
+func exponentialSmoothing(values []float64, alpha float64) float64 {
+	level := movingAverage(values)
+	for _, v := range values {
+		level = alpha*v + (1-alpha)*level
+	}
+	return level
+}
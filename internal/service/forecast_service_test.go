@@ -0,0 +1,51 @@
+package service
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMovingAverage(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		want   float64
+	}{
+		{"one day", []float64{5}, 5},
+		{"steady", []float64{3, 3, 3}, 3},
+		{"mean of the window", []float64{2, 4, 6}, 4},
+		{"quiet days count", []float64{0, 0, 9}, 3},
+		{"fractional units", []float64{0.5, 1.25}, 0.875},
+		{"no demand", []float64{0, 0, 0}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := movingAverage(tt.values); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("movingAverage(%v) = %v, want %v", tt.values, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExponentialSmoothing(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []float64
+		alpha  float64
+		want   float64
+	}{
+		{"steady", []float64{3, 3, 3}, 0.3, 3},
+		{"rising", []float64{2, 4, 6}, 0.5, 4.75},
+		{"alpha of one follows the last day", []float64{1, 2, 9}, 1, 9},
+		{"late spike", []float64{0, 0, 0, 10}, 0.2, 3.024},
+		{"quiet first day", []float64{0, 10, 10, 10}, 0.5, 9.21875},
+		{"no demand", []float64{0, 0, 0}, 0.3, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exponentialSmoothing(tt.values, tt.alpha); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("exponentialSmoothing(%v, %v) = %v, want %v", tt.values, tt.alpha, got, tt.want)
+			}
+		})
+	}
+}
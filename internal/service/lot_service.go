@@ -0,0 +1,39 @@
+package service
+
+import (
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+)
+
+type LotService interface {
+	ListByProduct(productID uint, warehouseID *uint, includeEmpty bool, page, pageSize int) ([]models.Lot, int64, error)
+	ListExpiring(days int, warehouseID *uint, page, pageSize int) ([]models.Lot, int64, error)
+}
+
+type lotService struct {
+	lotRepo     repository.LotRepository
+	productRepo repository.ProductRepository
+}
+
+func NewLotService(lotRepo repository.LotRepository, productRepo repository.ProductRepository) LotService {
+	return &lotService{
+		lotRepo:     lotRepo,
+		productRepo: productRepo,
+	}
+}
+
+func (s *lotService) ListByProduct(productID uint, warehouseID *uint, includeEmpty bool, page, pageSize int) ([]models.Lot, int64, error) {
+	// Verify product exists
+	if _, err := s.productRepo.FindByID(productID); err != nil {
+		return nil, 0, err
+	}
+
+	return s.lotRepo.ListByProduct(productID, warehouseID, includeEmpty, page, pageSize)
+}
+
+func (s *lotService) ListExpiring(days int, warehouseID *uint, page, pageSize int) ([]models.Lot, int64, error) {
+	before := time.Now().AddDate(0, 0, days)
+	return s.lotRepo.ListExpiring(before, warehouseID, page, pageSize)
+}
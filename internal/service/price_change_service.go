@@ -0,0 +1,134 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/pkg/websocket"
+)
+
+var (
+	ErrInvalidPriceSchedule = errors.New("price change must start in the future and end after it starts")
+)
+
+type PriceChangeService interface {
+	Schedule(productID uint, req *models.CreatePriceChangeRequest, userID uint) (*models.ScheduledPriceChange, error)
+	List(productID uint, status string, page, pageSize int) ([]models.ScheduledPriceChange, int64, error)
+	Cancel(productID, id uint) (*models.ScheduledPriceChange, error)
+	ApplyDue() (int, error)
+	RunScheduler(interval time.Duration)
+}
+
+type priceChangeService struct {
+	priceChangeRepo repository.PriceChangeRepository
+	productRepo     repository.ProductRepository
+	wsHub           *websocket.Hub
+}
+
+func NewPriceChangeService(priceChangeRepo repository.PriceChangeRepository, productRepo repository.ProductRepository, wsHub *websocket.Hub) PriceChangeService {
+	return &priceChangeService{
+		priceChangeRepo: priceChangeRepo,
+		productRepo:     productRepo,
+		wsHub:           wsHub,
+	}
+}
+
+func (s *priceChangeService) Schedule(productID uint, req *models.CreatePriceChangeRequest, userID uint) (*models.ScheduledPriceChange, error) {
+	if !req.StartsAt.After(time.Now()) || (req.EndsAt != nil && !req.EndsAt.After(req.StartsAt)) {
+		return nil, ErrInvalidPriceSchedule
+	}
+
+	change := &models.ScheduledPriceChange{
+		ProductID: productID,
+		Price:     req.Price,
+		StartsAt:  req.StartsAt,
+		EndsAt:    req.EndsAt,
+		Note:      req.Note,
+		UserID:    userRef(userID),
+	}
+
+	if err := s.priceChangeRepo.Create(change); err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
+
+func (s *priceChangeService) List(productID uint, status string, page, pageSize int) ([]models.ScheduledPriceChange, int64, error) {
+	// Verify product exists
+	if _, err := s.productRepo.FindByID(productID); err != nil {
+		return nil, 0, err
+	}
+
+	return s.priceChangeRepo.List(productID, status, page, pageSize)
+}
+
+// Cancel cancels a pending price change of the product
+func (s *priceChangeService) Cancel(productID, id uint) (*models.ScheduledPriceChange, error) {
+	change, err := s.priceChangeRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if change.ProductID != productID {
+		return nil, repository.ErrPriceChangeNotFound
+	}
+
+	return s.priceChangeRepo.Cancel(id)
+}
+
+// ApplyDue applies every price change that is due and returns how many
+// changed a product's price
+func (s *priceChangeService) ApplyDue() (int, error) {
+	applied, err := s.priceChangeRepo.ApplyDue(time.Now())
+
+	// Broadcast once per product even if the run stopped early
+	notified := make(map[uint]bool)
+	for _, change := range applied {
+		if !notified[change.ProductID] {
+			notified[change.ProductID] = true
+			s.broadcastProduct(change.ProductID)
+		}
+	}
+
+	return len(applied), err
+}
+
+// RunScheduler periodically applies scheduled price changes that are due.
+// It blocks, so it is meant to be started in its own goroutine.
+func (s *priceChangeService) RunScheduler(interval time.Duration) {
+	if interval <= 0 {
+		log.Println("Price change scheduler disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		count, err := s.ApplyDue()
+		if err != nil {
+			log.Printf("Error applying scheduled price changes: %v", err)
+		}
+		if count > 0 {
+			log.Printf("Applied %d scheduled price changes", count)
+		}
+	}
+}
+
+// broadcastProduct notifies clients that a product's price changed
+func (s *priceChangeService) broadcastProduct(productID uint) {
+	if s.wsHub == nil {
+		return
+	}
+
+	product, err := s.productRepo.FindByID(productID)
+	if err != nil {
+		log.Printf("Error loading product %d for price change event: %v", productID, err)
+		return
+	}
+
+	s.wsHub.BroadcastMessage(websocket.EventProductUpdated, product.ToResponse())
+}
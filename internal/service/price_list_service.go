@@ -0,0 +1,151 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+)
+
+var ErrListCurrencyMismatch = errors.New("price list currency differs from the product's")
+
+type PriceListService interface {
+	Create(req *models.CreatePriceListRequest) (*models.PriceList, error)
+	GetByID(id uint) (*models.PriceList, error)
+	Update(id uint, req *models.UpdatePriceListRequest) (*models.PriceList, error)
+	Delete(id uint) error
+	List(page, pageSize int, search string) ([]models.PriceList, int64, error)
+	SetPrices(id uint, req *models.SetPriceListPricesRequest) (*models.PriceList, error)
+	ResolvePrice(productID uint, listCode string, quantity models.Quantity) (*models.ResolvedPrice, error)
+	ResolvePrices(products []models.Product, listCode string, quantity models.Quantity) (map[uint]models.ResolvedPrice, error)
+}
+
+type priceListService struct {
+	priceListRepo repository.PriceListRepository
+	productRepo   repository.ProductRepository
+}
+
+func NewPriceListService(priceListRepo repository.PriceListRepository, productRepo repository.ProductRepository) PriceListService {
+	return &priceListService{
+		priceListRepo: priceListRepo,
+		productRepo:   productRepo,
+	}
+}
+
+func (s *priceListService) Create(req *models.CreatePriceListRequest) (*models.PriceList, error) {
+	list := &models.PriceList{
+		Code:        req.Code,
+		Name:        req.Name,
+		Description: req.Description,
+		Currency:    req.Currency,
+	}
+	if list.Currency == "" {
+		list.Currency = models.DefaultCurrency
+	}
+
+	if err := s.priceListRepo.Create(list); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (s *priceListService) GetByID(id uint) (*models.PriceList, error) {
+	return s.priceListRepo.FindByID(id)
+}
+
+func (s *priceListService) Update(id uint, req *models.UpdatePriceListRequest) (*models.PriceList, error) {
+	list, err := s.priceListRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Code != "" {
+		list.Code = req.Code
+	}
+	if req.Name != "" {
+		list.Name = req.Name
+	}
+	if req.Description != "" {
+		list.Description = req.Description
+	}
+	if req.Currency != "" {
+		list.Currency = req.Currency
+	}
+
+	if err := s.priceListRepo.Update(list); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (s *priceListService) Delete(id uint) error {
+	return s.priceListRepo.Delete(id)
+}
+
+func (s *priceListService) List(page, pageSize int, search string) ([]models.PriceList, int64, error) {
+	return s.priceListRepo.List(page, pageSize, search)
+}
+
+func (s *priceListService) SetPrices(id uint, req *models.SetPriceListPricesRequest) (*models.PriceList, error) {
+	if err := s.priceListRepo.SetPrices(id, req.Prices); err != nil {
+		return nil, err
+	}
+
+	return s.priceListRepo.FindByID(id)
+}
+
+// ResolvePrice returns the price of a quantity of a product on the named
+// price list, or at the product's own price when no list is named or the
+// list has no price for that quantity
+func (s *priceListService) ResolvePrice(productID uint, listCode string, quantity models.Quantity) (*models.ResolvedPrice, error) {
+	product, err := s.productRepo.FindByID(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	resolved, err := s.ResolvePrices([]models.Product{*product}, listCode, quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	price := resolved[product.ID]
+	return &price, nil
+}
+
+// ResolvePrices resolves the price of a quantity of each product on the
+// named price list, in one query for all of them. A list price for a product
+// priced in another currency fails with ErrListCurrencyMismatch rather than
+// being quoted as is.
+func (s *priceListService) ResolvePrices(products []models.Product, listCode string, quantity models.Quantity) (map[uint]models.ResolvedPrice, error) {
+	var list *models.PriceList
+	prices := map[uint]models.PriceListPrice{}
+	if listCode != "" {
+		var err error
+		if list, err = s.priceListRepo.FindByCode(listCode); err != nil {
+			return nil, err
+		}
+
+		ids := make([]uint, len(products))
+		for i, product := range products {
+			ids[i] = product.ID
+		}
+		if prices, err = s.priceListRepo.FindPrices(list.ID, ids, quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	resolved := make(map[uint]models.ResolvedPrice, len(products))
+	for _, product := range products {
+		var price *models.PriceListPrice
+		if p, ok := prices[product.ID]; ok {
+			if product.Currency != list.Currency {
+				return nil, ErrListCurrencyMismatch
+			}
+			price = &p
+		}
+		resolved[product.ID] = product.ResolvePrice(list, price, quantity)
+	}
+	return resolved, nil
+}
@@ -1,6 +1,8 @@
 package service
 
 import (
+	"errors"
+	"log"
 	"time"
 
 	"github.com/brunobarlari/inventorypulse/internal/domain/models"
@@ -8,38 +10,68 @@ import (
 	"github.com/brunobarlari/inventorypulse/pkg/websocket"
 )
 
+var (
+	ErrInvalidMovementQuantity = errors.New("movement quantity does not match its type")
+	ErrTrackingWithStock       = errors.New("lot tracking and serial numbers can only be switched at zero stock")
+)
+
 type ProductService interface {
 	Create(req *models.CreateProductRequest) (*models.Product, error)
 	GetByID(id uint) (*models.Product, error)
 	Update(id uint, req *models.UpdateProductRequest) (*models.Product, error)
 	Delete(id uint) error
 	List(page, pageSize int, categoryID *uint, search string) ([]models.Product, int64, error)
-	UpdateStock(id uint, stock int) (*models.Product, error)
+	UpdateStock(id uint, stock models.Quantity, unit string, userID uint) (*models.Product, error)
+	GetStockLevels(id uint) ([]models.ProductStock, error)
+	UpdateStockAt(id, warehouseID uint, stock models.Quantity, unit string, userID uint) (*models.Product, error)
+	RecordMovement(productID uint, req *models.CreateStockMovementRequest, userID uint) (*models.StockMovement, error)
+	ListMovements(productID uint, movementType string, start, end *time.Time, page, pageSize int) ([]models.StockMovement, int64, error)
 	GetHistory(productID uint, start, end *time.Time, page, pageSize int) ([]models.ProductHistory, int64, error)
 }
 
 type productService struct {
 	productRepo        repository.ProductRepository
 	productHistoryRepo repository.ProductHistoryRepository
+	movementRepo       repository.StockMovementRepository
+	alertService       AlertService
 	wsHub              *websocket.Hub
 }
 
-func NewProductService(productRepo repository.ProductRepository, productHistoryRepo repository.ProductHistoryRepository, wsHub *websocket.Hub) ProductService {
+func NewProductService(productRepo repository.ProductRepository, productHistoryRepo repository.ProductHistoryRepository, movementRepo repository.StockMovementRepository, alertService AlertService, wsHub *websocket.Hub) ProductService {
 	return &productService{
 		productRepo:        productRepo,
 		productHistoryRepo: productHistoryRepo,
+		movementRepo:       movementRepo,
+		alertService:       alertService,
 		wsHub:              wsHub,
 	}
 }
 
 func (s *productService) Create(req *models.CreateProductRequest) (*models.Product, error) {
 	product := &models.Product{
-		Name:        req.Name,
-		Description: req.Description,
-		SKU:         req.SKU,
-		Stock:       req.Stock,
-		Price:       req.Price,
-		CategoryID:  req.CategoryID,
+		Name:              req.Name,
+		Description:       req.Description,
+		SKU:               req.SKU,
+		Stock:             req.Stock,
+		Price:             req.Price,
+		Currency:          req.Currency,
+		AverageCost:       req.UnitCost,
+		CategoryID:        req.CategoryID,
+		ReorderPoint:      req.ReorderPoint,
+		ReorderQuantity:   req.ReorderQuantity,
+		LotTracked:        req.LotTracked,
+		Serialized:        req.Serialized,
+		BaseUnit:          req.BaseUnit,
+		QuantityPrecision: req.QuantityPrecision,
+	}
+	if req.TaxClassID != nil && *req.TaxClassID > 0 {
+		product.TaxClassID = req.TaxClassID
+	}
+	if product.BaseUnit == "" {
+		product.BaseUnit = models.DefaultBaseUnit
+	}
+	if product.Currency == "" {
+		product.Currency = models.DefaultCurrency
 	}
 
 	if err := s.productRepo.Create(product, req.CategoryIDs); err != nil {
@@ -52,20 +84,27 @@ func (s *productService) Create(req *models.CreateProductRequest) (*models.Produ
 		return nil, err
 	}
 
-	// Save initial history record
-	history := &models.ProductHistory{
-		ProductID: product.ID,
-		Price:     product.Price,
-		Stock:     product.Stock,
-		ChangedAt: time.Now(),
+	// Save initial history record; initial stock is recorded by its movement
+	if product.Stock == 0 {
+		history := &models.ProductHistory{
+			ProductID: product.ID,
+			Price:     product.Price,
+			Currency:  product.Currency,
+			Stock:     product.Stock,
+			ChangedAt: time.Now(),
+		}
+		s.productHistoryRepo.Create(history)
 	}
-	s.productHistoryRepo.Create(history)
 
 	// Broadcast WebSocket event
 	if s.wsHub != nil {
 		s.wsHub.BroadcastMessage(websocket.EventProductCreated, product.ToResponse())
 	}
 
+	if s.alertService != nil {
+		s.alertService.Evaluate(product)
+	}
+
 	return product, nil
 }
 
@@ -79,11 +118,9 @@ func (s *productService) Update(id uint, req *models.UpdateProductRequest) (*mod
 		return nil, err
 	}
 
-	// Track if price or stock changed for history
+	// Track if price, currency or stock changed for history
 	priceChanged := false
 	stockChanged := false
-	oldPrice := product.Price
-	oldStock := product.Stock
 
 	if req.Name != "" {
 		product.Name = req.Name
@@ -94,10 +131,10 @@ func (s *productService) Update(id uint, req *models.UpdateProductRequest) (*mod
 	if req.SKU != "" {
 		product.SKU = req.SKU
 	}
-	if req.Stock != nil {
+	// A bundle's stock follows its components, so it is not set directly
+	if req.Stock != nil && !product.IsBundle() {
 		if *req.Stock != product.Stock {
 			stockChanged = true
-			product.Stock = *req.Stock
 		}
 	}
 	if req.Price != nil && *req.Price > 0 {
@@ -106,42 +143,90 @@ func (s *productService) Update(id uint, req *models.UpdateProductRequest) (*mod
 			product.Price = *req.Price
 		}
 	}
+	if req.Currency != "" && req.Currency != product.Currency {
+		priceChanged = true
+		product.Currency = req.Currency
+	}
 	if req.CategoryID > 0 {
 		product.CategoryID = req.CategoryID
 	}
+	if req.ReorderPoint != nil {
+		product.ReorderPoint = *req.ReorderPoint
+	}
+	if req.ReorderQuantity != nil {
+		product.ReorderQuantity = *req.ReorderQuantity
+	}
+	// Stock on hand has no lots or serials to match a switched setting, so
+	// tracking only changes while the product holds none
+	if req.LotTracked != nil && *req.LotTracked != product.LotTracked {
+		if product.Stock != 0 {
+			return nil, ErrTrackingWithStock
+		}
+		product.LotTracked = *req.LotTracked
+	}
+	if req.Serialized != nil && *req.Serialized != product.Serialized {
+		if product.Stock != 0 {
+			return nil, ErrTrackingWithStock
+		}
+		product.Serialized = *req.Serialized
+	}
+	if req.BaseUnit != "" {
+		product.BaseUnit = req.BaseUnit
+	}
+	if req.QuantityPrecision != nil {
+		product.QuantityPrecision = *req.QuantityPrecision
+	}
+	if req.TaxClassID != nil {
+		product.TaxClassID = req.TaxClassID
+		if *req.TaxClassID == 0 {
+			product.TaxClassID = nil
+		}
+	}
 
 	if err := s.productRepo.Update(product, req.CategoryIDs); err != nil {
 		return nil, err
 	}
 
+	// Stock is held per warehouse, so it is adjusted separately
+	var movement *models.StockMovement
+	if stockChanged {
+		movement, err = s.productRepo.UpdateStock(product.ID, *req.Stock, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Reload with category
 	product, err = s.productRepo.FindByID(product.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	// Record history if price or stock changed
-	if priceChanged || stockChanged {
+	// Record history if price changed; a stock change already wrote a
+	// history row together with its movement
+	if priceChanged && !stockChanged {
 		history := &models.ProductHistory{
 			ProductID: product.ID,
 			Price:     product.Price,
+			Currency:  product.Currency,
 			Stock:     product.Stock,
 			ChangedAt: time.Now(),
 		}
 		s.productHistoryRepo.Create(history)
-
-		// Log changes for debugging
-		if priceChanged {
-			_ = oldPrice // suppress unused variable warning
-		}
-		if stockChanged {
-			_ = oldStock // suppress unused variable warning
-		}
 	}
 
-	// Broadcast WebSocket event
+	// Broadcast WebSocket events, with stock.updated for the warehouse whose
+	// stock was set as the other stock paths do
 	if s.wsHub != nil {
 		s.wsHub.BroadcastMessage(websocket.EventProductUpdated, product.ToResponse())
+		if movement != nil {
+			s.wsHub.BroadcastMessage(websocket.EventStockUpdated, stockUpdatedEvent(product, movement.WarehouseID))
+		}
+	}
+
+	// Stock or the reorder point may have changed
+	if s.alertService != nil {
+		s.alertService.Evaluate(product)
 	}
 
 	return product, nil
@@ -170,42 +255,124 @@ func (s *productService) List(page, pageSize int, categoryID *uint, search strin
 	return s.productRepo.List(page, pageSize, categoryID, search)
 }
 
-func (s *productService) UpdateStock(id uint, stock int) (*models.Product, error) {
+func (s *productService) UpdateStock(id uint, stock models.Quantity, unit string, userID uint) (*models.Product, error) {
+	stock, _, err := s.productRepo.BaseQuantity(id, unit, stock)
+	if err != nil {
+		return nil, err
+	}
+
+	movement, err := s.productRepo.UpdateStock(id, stock, userRef(userID))
+	if err != nil {
+		return nil, err
+	}
+
 	product, err := s.productRepo.FindByID(id)
 	if err != nil {
 		return nil, err
 	}
 
-	oldStock := product.Stock
+	if movement != nil {
+		s.stockChanged(product, movement.WarehouseID)
+	}
 
-	if err := s.productRepo.UpdateStock(id, stock); err != nil {
+	return product, nil
+}
+
+func (s *productService) GetStockLevels(id uint) ([]models.ProductStock, error) {
+	// Verify product exists
+	if _, err := s.productRepo.FindByID(id); err != nil {
 		return nil, err
 	}
 
-	product, err = s.productRepo.FindByID(id)
+	return s.productRepo.GetStockLevels(id)
+}
+
+func (s *productService) UpdateStockAt(id, warehouseID uint, stock models.Quantity, unit string, userID uint) (*models.Product, error) {
+	stock, _, err := s.productRepo.BaseQuantity(id, unit, stock)
 	if err != nil {
 		return nil, err
 	}
 
-	// Record history if stock changed
-	if oldStock != stock {
-		history := &models.ProductHistory{
-			ProductID: product.ID,
-			Price:     product.Price,
-			Stock:     product.Stock,
-			ChangedAt: time.Now(),
-		}
-		s.productHistoryRepo.Create(history)
+	movement, err := s.productRepo.UpdateStockAt(id, warehouseID, stock, userRef(userID))
+	if err != nil {
+		return nil, err
 	}
 
-	// Broadcast WebSocket event
-	if s.wsHub != nil {
-		s.wsHub.BroadcastMessage(websocket.EventStockUpdated, product.ToResponse())
+	product, err := s.productRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if movement != nil {
+		s.stockChanged(product, warehouseID)
 	}
 
 	return product, nil
 }
 
+func (s *productService) RecordMovement(productID uint, req *models.CreateStockMovementRequest, userID uint) (*models.StockMovement, error) {
+	// The sign of the delta must agree with the movement type
+	direction := req.Type.Direction()
+	if req.Quantity == 0 || (direction > 0 && req.Quantity < 0) || (direction < 0 && req.Quantity > 0) {
+		return nil, ErrInvalidMovementQuantity
+	}
+
+	quantity, factor, err := s.productRepo.BaseQuantity(productID, req.Unit, req.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	movement := &models.StockMovement{
+		ProductID:   productID,
+		WarehouseID: req.WarehouseID,
+		Type:        req.Type,
+		Quantity:    quantity,
+		Reason:      req.Reason,
+		Reference:   req.Reference,
+		UserID:      userRef(userID),
+	}
+	if quantity > 0 {
+		movement.Lots = req.LotInput.Entries(quantity)
+		if req.UnitCost != nil {
+			unitCost := req.UnitCost.Div(factor)
+			movement.UnitCost = &unitCost
+		}
+	}
+	movement.Serials = req.SerialInput.Entries()
+
+	if err := s.movementRepo.Create(movement); err != nil {
+		return nil, err
+	}
+
+	product, err := s.productRepo.FindByID(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.stockChanged(product, movement.WarehouseID)
+
+	// A bundle's components changed with it
+	for _, component := range movement.Components {
+		product, err := s.productRepo.FindByID(component.ProductID)
+		if err != nil {
+			log.Printf("Error loading product %d after bundle movement: %v", component.ProductID, err)
+			continue
+		}
+		s.stockChanged(product, component.WarehouseID)
+	}
+
+	return movement, nil
+}
+
+func (s *productService) ListMovements(productID uint, movementType string, start, end *time.Time, page, pageSize int) ([]models.StockMovement, int64, error) {
+	// Verify product exists
+	if _, err := s.productRepo.FindByID(productID); err != nil {
+		return nil, 0, err
+	}
+
+	return s.movementRepo.FindByProductID(productID, movementType, start, end, page, pageSize)
+}
+
 func (s *productService) GetHistory(productID uint, start, end *time.Time, page, pageSize int) ([]models.ProductHistory, int64, error) {
 	// Verify product exists
 	_, err := s.productRepo.FindByID(productID)
@@ -215,3 +382,45 @@ func (s *productService) GetHistory(productID uint, start, end *time.Time, page,
 
 	return s.productHistoryRepo.FindByProductID(productID, start, end, page, pageSize)
 }
+
+// stockChanged broadcasts the stock.updated event and re-evaluates alerts
+func (s *productService) stockChanged(product *models.Product, warehouseID uint) {
+	if s.wsHub != nil {
+		s.wsHub.BroadcastMessage(websocket.EventStockUpdated, stockUpdatedEvent(product, warehouseID))
+	}
+	if s.alertService != nil {
+		s.alertService.Evaluate(product)
+	}
+}
+
+// stockUpdatedEvent builds the stock.updated payload for the given warehouse
+func stockUpdatedEvent(product *models.Product, warehouseID uint) models.StockUpdatedEvent {
+	event := models.StockUpdatedEvent{ProductResponse: product.ToResponse()}
+	for _, stock := range product.Stocks {
+		if stock.WarehouseID == warehouseID {
+			location := stock.ToResponse()
+			event.Location = &location
+			break
+		}
+	}
+	return event
+}
+
+// withComponentMovements returns the movements followed by the component
+// movements of any bundles among them, whose products changed as well
+func withComponentMovements(movements []models.StockMovement) []models.StockMovement {
+	all := make([]models.StockMovement, 0, len(movements))
+	all = append(all, movements...)
+	for _, movement := range movements {
+		all = append(all, movement.Components...)
+	}
+	return all
+}
+
+// userRef converts an authenticated user ID into an optional reference
+func userRef(userID uint) *uint {
+	if userID == 0 {
+		return nil
+	}
+	return &userID
+}
@@ -0,0 +1,44 @@
+package service
+
+import (
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/pkg/websocket"
+)
+
+type ProductUnitService interface {
+	SetUnits(productID uint, req *models.SetProductUnitsRequest) (*models.Product, error)
+}
+
+type productUnitService struct {
+	unitRepo    repository.ProductUnitRepository
+	productRepo repository.ProductRepository
+	wsHub       *websocket.Hub
+}
+
+func NewProductUnitService(unitRepo repository.ProductUnitRepository, productRepo repository.ProductRepository, wsHub *websocket.Hub) ProductUnitService {
+	return &productUnitService{
+		unitRepo:    unitRepo,
+		productRepo: productRepo,
+		wsHub:       wsHub,
+	}
+}
+
+// SetUnits replaces a product's alternative units and returns the product
+func (s *productUnitService) SetUnits(productID uint, req *models.SetProductUnitsRequest) (*models.Product, error) {
+	if err := s.unitRepo.SetUnits(productID, req.BaseUnit, req.Units); err != nil {
+		return nil, err
+	}
+
+	product, err := s.productRepo.FindByID(productID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Broadcast WebSocket event
+	if s.wsHub != nil {
+		s.wsHub.BroadcastMessage(websocket.EventProductUpdated, product.ToResponse())
+	}
+
+	return product, nil
+}
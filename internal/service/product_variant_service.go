@@ -0,0 +1,87 @@
+package service
+
+import (
+	"log"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/pkg/websocket"
+)
+
+type ProductVariantService interface {
+	ListVariants(parentID uint) (*models.Product, []models.Product, error)
+	Generate(parentID uint, req *models.GenerateVariantsRequest) (*models.Product, []models.Product, int, error)
+}
+
+type productVariantService struct {
+	variantRepo        repository.ProductVariantRepository
+	productRepo        repository.ProductRepository
+	productHistoryRepo repository.ProductHistoryRepository
+	wsHub              *websocket.Hub
+}
+
+func NewProductVariantService(variantRepo repository.ProductVariantRepository, productRepo repository.ProductRepository, productHistoryRepo repository.ProductHistoryRepository, wsHub *websocket.Hub) ProductVariantService {
+	return &productVariantService{
+		variantRepo:        variantRepo,
+		productRepo:        productRepo,
+		productHistoryRepo: productHistoryRepo,
+		wsHub:              wsHub,
+	}
+}
+
+// ListVariants returns a parent product with its variants
+func (s *productVariantService) ListVariants(parentID uint) (*models.Product, []models.Product, error) {
+	parent, err := s.productRepo.FindByID(parentID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	variants, err := s.variantRepo.ListVariants(parentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return parent, variants, nil
+}
+
+// Generate creates the missing variants of a parent product and returns the
+// parent with all its variants and the number created
+func (s *productVariantService) Generate(parentID uint, req *models.GenerateVariantsRequest) (*models.Product, []models.Product, int, error) {
+	created, err := s.variantRepo.Generate(parentID, req.Options, req.Price)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	for _, variant := range created {
+		// New variants start without stock, like any new product
+		history := &models.ProductHistory{
+			ProductID: variant.ID,
+			Price:     variant.Price,
+			Currency:  variant.Currency,
+			Stock:     0,
+			ChangedAt: time.Now(),
+		}
+		s.productHistoryRepo.Create(history)
+
+		if s.wsHub != nil {
+			product, err := s.productRepo.FindByID(variant.ID)
+			if err != nil {
+				log.Printf("Error loading variant %d after generation: %v", variant.ID, err)
+				continue
+			}
+			s.wsHub.BroadcastMessage(websocket.EventProductCreated, product.ToResponse())
+		}
+	}
+
+	parent, variants, err := s.ListVariants(parentID)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	// The parent's axes and totals changed
+	if s.wsHub != nil {
+		s.wsHub.BroadcastMessage(websocket.EventProductUpdated, parent.ToResponse())
+	}
+
+	return parent, variants, len(created), nil
+}
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"log"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/pkg/websocket"
+)
+
+type PurchaseOrderService interface {
+	Create(req *models.CreatePurchaseOrderRequest, userID uint) (*models.PurchaseOrder, error)
+	GetByID(id uint) (*models.PurchaseOrder, error)
+	Update(id uint, req *models.UpdatePurchaseOrderRequest) (*models.PurchaseOrder, error)
+	Delete(id uint) error
+	List(supplierID *uint, status string, page, pageSize int) ([]models.PurchaseOrder, int64, error)
+	Send(id uint) (*models.PurchaseOrder, error)
+	Receive(id uint, req *models.ReceivePurchaseOrderRequest, userID uint) (*models.PurchaseOrder, error)
+	Close(id uint) (*models.PurchaseOrder, error)
+}
+
+type purchaseOrderService struct {
+	orderRepo    repository.PurchaseOrderRepository
+	productRepo  repository.ProductRepository
+	alertService AlertService
+	wsHub        *websocket.Hub
+}
+
+func NewPurchaseOrderService(orderRepo repository.PurchaseOrderRepository, productRepo repository.ProductRepository, alertService AlertService, wsHub *websocket.Hub) PurchaseOrderService {
+	return &purchaseOrderService{
+		orderRepo:    orderRepo,
+		productRepo:  productRepo,
+		alertService: alertService,
+		wsHub:        wsHub,
+	}
+}
+
+func (s *purchaseOrderService) Create(req *models.CreatePurchaseOrderRequest, userID uint) (*models.PurchaseOrder, error) {
+	lines, err := s.purchaseOrderLines(req.Lines)
+	if err != nil {
+		return nil, err
+	}
+
+	order := &models.PurchaseOrder{
+		SupplierID:  req.SupplierID,
+		WarehouseID: req.WarehouseID,
+		Notes:       req.Notes,
+		Currency:    req.Currency,
+		ExpectedAt:  req.ExpectedAt,
+		UserID:      userRef(userID),
+		Lines:       lines,
+	}
+	if order.Currency == "" {
+		order.Currency = models.DefaultCurrency
+	}
+
+	if err := s.orderRepo.Create(order); err != nil {
+		return nil, err
+	}
+
+	return s.orderRepo.FindByID(order.ID)
+}
+
+func (s *purchaseOrderService) GetByID(id uint) (*models.PurchaseOrder, error) {
+	return s.orderRepo.FindByID(id)
+}
+
+func (s *purchaseOrderService) Update(id uint, req *models.UpdatePurchaseOrderRequest) (*models.PurchaseOrder, error) {
+	order, err := s.orderRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.SupplierID > 0 {
+		order.SupplierID = req.SupplierID
+	}
+	if req.WarehouseID > 0 {
+		order.WarehouseID = req.WarehouseID
+	}
+	if req.Notes != "" {
+		order.Notes = req.Notes
+	}
+	if req.Currency != "" {
+		order.Currency = req.Currency
+	}
+	if req.ExpectedAt != nil {
+		order.ExpectedAt = req.ExpectedAt
+	}
+
+	var lines []models.PurchaseOrderLine
+	if req.Lines != nil {
+		if lines, err = s.purchaseOrderLines(req.Lines); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.orderRepo.Update(order, lines); err != nil {
+		return nil, err
+	}
+
+	return s.orderRepo.FindByID(id)
+}
+
+func (s *purchaseOrderService) Delete(id uint) error {
+	return s.orderRepo.Delete(id)
+}
+
+func (s *purchaseOrderService) List(supplierID *uint, status string, page, pageSize int) ([]models.PurchaseOrder, int64, error) {
+	return s.orderRepo.List(supplierID, status, page, pageSize)
+}
+
+func (s *purchaseOrderService) Send(id uint) (*models.PurchaseOrder, error) {
+	order, err := s.orderRepo.Send(id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastOrder(order)
+
+	return order, nil
+}
+
+func (s *purchaseOrderService) Receive(id uint, req *models.ReceivePurchaseOrderRequest, userID uint) (*models.PurchaseOrder, error) {
+	receipts, err := s.baseReceipts(id, req.Lines)
+	if err != nil {
+		return nil, err
+	}
+
+	order, movements, err := s.orderRepo.Receive(id, receipts, userRef(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, movement := range withComponentMovements(movements) {
+		product, err := s.productRepo.FindByID(movement.ProductID)
+		if err != nil {
+			log.Printf("Error loading product %d after receipt: %v", movement.ProductID, err)
+			continue
+		}
+		if s.wsHub != nil {
+			s.wsHub.BroadcastMessage(websocket.EventStockUpdated, stockUpdatedEvent(product, movement.WarehouseID))
+		}
+		if s.alertService != nil {
+			s.alertService.Evaluate(product)
+		}
+	}
+
+	s.broadcastOrder(order)
+
+	return order, nil
+}
+
+func (s *purchaseOrderService) Close(id uint) (*models.PurchaseOrder, error) {
+	order, err := s.orderRepo.Close(id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastOrder(order)
+
+	return order, nil
+}
+
+// broadcastOrder notifies clients that a purchase order changed status
+func (s *purchaseOrderService) broadcastOrder(order *models.PurchaseOrder) {
+	if s.wsHub != nil {
+		s.wsHub.BroadcastMessage(websocket.EventPurchaseOrderUpdated, order.ToResponse())
+	}
+}
+
+// purchaseOrderLines converts request lines into purchase order lines, with
+// quantities in each product's base unit and costs per the unit ordered
+func (s *purchaseOrderService) purchaseOrderLines(reqLines []models.PurchaseOrderLineRequest) ([]models.PurchaseOrderLine, error) {
+	lines := make([]models.PurchaseOrderLine, len(reqLines))
+	for i, line := range reqLines {
+		quantity, factor, err := s.productRepo.BaseQuantity(line.ProductID, line.Unit, line.Quantity)
+		if err != nil {
+			return nil, err
+		}
+		lines[i] = models.PurchaseOrderLine{
+			ProductID:       line.ProductID,
+			QuantityOrdered: quantity,
+			UnitCost:        line.UnitCost,
+			Unit:            line.Unit,
+			UnitFactor:      factor,
+		}
+	}
+	return lines, nil
+}
+
+// baseReceipts returns the receipts with quantities in the base unit of
+// each line's product. Receipts against unknown lines are left for the
+// repository to reject.
+func (s *purchaseOrderService) baseReceipts(id uint, receipts []models.ReceiveLineRequest) ([]models.ReceiveLineRequest, error) {
+	order, err := s.orderRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+	products := make(map[uint]uint, len(order.Lines))
+	for _, line := range order.Lines {
+		products[line.ID] = line.ProductID
+	}
+
+	converted := make([]models.ReceiveLineRequest, len(receipts))
+	for i, receipt := range receipts {
+		converted[i] = receipt
+		productID, ok := products[receipt.LineID]
+		if !ok {
+			continue
+		}
+		quantity, _, err := s.productRepo.BaseQuantity(productID, receipt.Unit, receipt.Quantity)
+		if err != nil {
+			return nil, err
+		}
+		converted[i].Quantity = quantity
+		converted[i].Unit = ""
+	}
+	return converted, nil
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"log"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/pkg/websocket"
+)
+
+type ReservationService interface {
+	Create(req *models.CreateReservationRequest, userID uint) (*models.Reservation, error)
+	GetByID(id uint) (*models.Reservation, error)
+	List(productID *uint, status string, page, pageSize int) ([]models.Reservation, int64, error)
+	Confirm(id uint, req *models.ConfirmReservationRequest, userID uint) (*models.Reservation, error)
+	Release(id uint) (*models.Reservation, error)
+	ReleaseExpired() (int, error)
+	RunExpirySweeper(interval time.Duration)
+}
+
+type reservationService struct {
+	reservationRepo repository.ReservationRepository
+	productRepo     repository.ProductRepository
+	alertService    AlertService
+	defaultTTL      time.Duration
+	wsHub           *websocket.Hub
+}
+
+func NewReservationService(reservationRepo repository.ReservationRepository, productRepo repository.ProductRepository, alertService AlertService, defaultTTL time.Duration, wsHub *websocket.Hub) ReservationService {
+	return &reservationService{
+		reservationRepo: reservationRepo,
+		productRepo:     productRepo,
+		alertService:    alertService,
+		defaultTTL:      defaultTTL,
+		wsHub:           wsHub,
+	}
+}
+
+func (s *reservationService) Create(req *models.CreateReservationRequest, userID uint) (*models.Reservation, error) {
+	ttl := s.defaultTTL
+	if req.TTLMinutes > 0 {
+		ttl = time.Duration(req.TTLMinutes) * time.Minute
+	}
+	expiresAt := time.Now().Add(ttl)
+
+	reservation := &models.Reservation{
+		ProductID: req.ProductID,
+		Quantity:  req.Quantity,
+		Reference: req.Reference,
+		ExpiresAt: &expiresAt,
+		UserID:    userRef(userID),
+	}
+
+	if err := s.reservationRepo.Create(reservation); err != nil {
+		return nil, err
+	}
+
+	s.broadcastAvailability(reservation.ProductID)
+
+	return reservation, nil
+}
+
+func (s *reservationService) GetByID(id uint) (*models.Reservation, error) {
+	return s.reservationRepo.FindByID(id)
+}
+
+func (s *reservationService) List(productID *uint, status string, page, pageSize int) ([]models.Reservation, int64, error) {
+	return s.reservationRepo.List(productID, status, page, pageSize)
+}
+
+func (s *reservationService) Confirm(id uint, req *models.ConfirmReservationRequest, userID uint) (*models.Reservation, error) {
+	reservation, movement, err := s.reservationRepo.Confirm(id, req.WarehouseID, req.SerialInput.Entries(), userRef(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Confirming decrements stock, so both stock and availability changed
+	product, err := s.productRepo.FindByID(reservation.ProductID)
+	if err != nil {
+		return nil, err
+	}
+	if s.wsHub != nil {
+		s.wsHub.BroadcastMessage(websocket.EventStockUpdated, stockUpdatedEvent(product, movement.WarehouseID))
+		s.wsHub.BroadcastMessage(websocket.EventStockAvailability, product.ToResponse())
+	}
+	if s.alertService != nil {
+		s.alertService.Evaluate(product)
+	}
+
+	// Selling a bundle decremented its components
+	for _, component := range movement.Components {
+		product, err := s.productRepo.FindByID(component.ProductID)
+		if err != nil {
+			log.Printf("Error loading product %d after confirmation: %v", component.ProductID, err)
+			continue
+		}
+		if s.wsHub != nil {
+			s.wsHub.BroadcastMessage(websocket.EventStockUpdated, stockUpdatedEvent(product, component.WarehouseID))
+			s.wsHub.BroadcastMessage(websocket.EventStockAvailability, product.ToResponse())
+		}
+		if s.alertService != nil {
+			s.alertService.Evaluate(product)
+		}
+	}
+
+	return reservation, nil
+}
+
+func (s *reservationService) Release(id uint) (*models.Reservation, error) {
+	reservation, err := s.reservationRepo.Release(id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastAvailability(reservation.ProductID)
+
+	return reservation, nil
+}
+
+// ReleaseExpired releases all reservations past their expiry and returns
+// how many were released
+func (s *reservationService) ReleaseExpired() (int, error) {
+	released, err := s.reservationRepo.ReleaseExpired(time.Now())
+
+	// Broadcast once per product even if the sweep stopped early
+	notified := make(map[uint]bool)
+	for _, reservation := range released {
+		if !notified[reservation.ProductID] {
+			notified[reservation.ProductID] = true
+			s.broadcastAvailability(reservation.ProductID)
+		}
+	}
+
+	return len(released), err
+}
+
+// RunExpirySweeper periodically releases expired reservations. It blocks,
+// so it is meant to be started in its own goroutine.
+func (s *reservationService) RunExpirySweeper(interval time.Duration) {
+	if interval <= 0 {
+		log.Println("Reservation expiry sweeper disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		count, err := s.ReleaseExpired()
+		if err != nil {
+			log.Printf("Error releasing expired reservations: %v", err)
+		}
+		if count > 0 {
+			log.Printf("Released %d expired reservations", count)
+		}
+	}
+}
+
+// broadcastAvailability notifies clients that a product's available stock
+// changed. A bundle's reservation holds its components, so they are notified
+// too.
+func (s *reservationService) broadcastAvailability(productID uint) {
+	if s.wsHub == nil {
+		return
+	}
+
+	product, err := s.productRepo.FindByID(productID)
+	if err != nil {
+		log.Printf("Error loading product %d for availability event: %v", productID, err)
+		return
+	}
+	s.wsHub.BroadcastMessage(websocket.EventStockAvailability, product.ToResponse())
+
+	for _, component := range product.Components {
+		held, err := s.productRepo.FindByID(component.ComponentID)
+		if err != nil {
+			log.Printf("Error loading product %d for availability event: %v", component.ComponentID, err)
+			continue
+		}
+		s.wsHub.BroadcastMessage(websocket.EventStockAvailability, held.ToResponse())
+	}
+}
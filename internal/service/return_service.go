@@ -0,0 +1,136 @@
+package service
+
+import (
+	"log"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/pkg/websocket"
+)
+
+type ReturnService interface {
+	Create(req *models.CreateReturnRequest, userID uint) (*models.ReturnAuthorization, error)
+	GetByID(id uint) (*models.ReturnAuthorization, error)
+	List(productID uint, status, disposition string, page, pageSize int) ([]models.ReturnAuthorization, int64, error)
+	Inspect(id uint, req *models.InspectReturnRequest, userID uint) (*models.ReturnAuthorization, error)
+	Dispose(id uint, req *models.DisposeReturnRequest, userID uint) (*models.ReturnAuthorization, error)
+	Summary(productID uint, start, end *time.Time) (*models.ReturnSummary, error)
+}
+
+type returnService struct {
+	returnRepo   repository.ReturnRepository
+	productRepo  repository.ProductRepository
+	alertService AlertService
+	wsHub        *websocket.Hub
+}
+
+func NewReturnService(returnRepo repository.ReturnRepository, productRepo repository.ProductRepository, alertService AlertService, wsHub *websocket.Hub) ReturnService {
+	return &returnService{
+		returnRepo:   returnRepo,
+		productRepo:  productRepo,
+		alertService: alertService,
+		wsHub:        wsHub,
+	}
+}
+
+func (s *returnService) Create(req *models.CreateReturnRequest, userID uint) (*models.ReturnAuthorization, error) {
+	product, err := s.productRepo.FindByID(req.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	factor, ok := product.UnitFactor(req.Unit)
+	if !ok {
+		return nil, repository.ErrUnknownUnit
+	}
+
+	ret := &models.ReturnAuthorization{
+		ProductID: req.ProductID,
+		Quantity:  req.Quantity.Mul(factor),
+		Serial:    req.Serial,
+		LotNumber: req.LotNumber,
+		Customer:  req.Customer,
+		Reference: req.Reference,
+		Reason:    req.Reason,
+		UserID:    userRef(userID),
+	}
+
+	if err := s.returnRepo.Create(ret); err != nil {
+		return nil, err
+	}
+
+	ret, err = s.returnRepo.FindByID(ret.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastReturn(websocket.EventReturnCreated, ret)
+
+	return ret, nil
+}
+
+func (s *returnService) GetByID(id uint) (*models.ReturnAuthorization, error) {
+	return s.returnRepo.FindByID(id)
+}
+
+func (s *returnService) List(productID uint, status, disposition string, page, pageSize int) ([]models.ReturnAuthorization, int64, error) {
+	return s.returnRepo.List(productID, status, disposition, page, pageSize)
+}
+
+func (s *returnService) Inspect(id uint, req *models.InspectReturnRequest, userID uint) (*models.ReturnAuthorization, error) {
+	ret, err := s.returnRepo.Inspect(id, req.Condition, req.Notes, userRef(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastReturn(websocket.EventReturnUpdated, ret)
+
+	return ret, nil
+}
+
+// Dispose settles an inspected return; only restocking changes stock
+func (s *returnService) Dispose(id uint, req *models.DisposeReturnRequest, userID uint) (*models.ReturnAuthorization, error) {
+	ret, movement, err := s.returnRepo.Dispose(id, req.Disposition, req.WarehouseID, req.Notes, userRef(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	if movement != nil {
+		for _, moved := range withComponentMovements([]models.StockMovement{*movement}) {
+			product, err := s.productRepo.FindByID(moved.ProductID)
+			if err != nil {
+				log.Printf("Error loading product %d after restocking return: %v", moved.ProductID, err)
+				continue
+			}
+			if s.wsHub != nil {
+				s.wsHub.BroadcastMessage(websocket.EventStockUpdated, stockUpdatedEvent(product, moved.WarehouseID))
+			}
+			if s.alertService != nil {
+				s.alertService.Evaluate(product)
+			}
+		}
+	}
+
+	s.broadcastReturn(websocket.EventReturnUpdated, ret)
+
+	return ret, nil
+}
+
+func (s *returnService) Summary(productID uint, start, end *time.Time) (*models.ReturnSummary, error) {
+	if productID != 0 {
+		// Verify product exists
+		if _, err := s.productRepo.FindByID(productID); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.returnRepo.Summary(productID, start, end)
+}
+
+// broadcastReturn notifies clients that a return was created or changed
+func (s *returnService) broadcastReturn(event string, ret *models.ReturnAuthorization) {
+	if s.wsHub != nil {
+		s.wsHub.BroadcastMessage(event, ret.ToResponse())
+	}
+}
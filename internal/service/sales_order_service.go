@@ -0,0 +1,205 @@
+package service
+
+import (
+	"errors"
+	"log"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/pkg/websocket"
+)
+
+var ErrCurrencyMismatch = errors.New("all lines of an order must be priced in the same currency")
+
+type SalesOrderService interface {
+	Create(req *models.CreateSalesOrderRequest, userID uint) (*models.SalesOrder, error)
+	GetByID(id uint) (*models.SalesOrder, error)
+	List(status string, page, pageSize int) ([]models.SalesOrder, int64, error)
+	Allocate(id, userID uint) (*models.SalesOrder, error)
+	Pick(id uint) (*models.SalesOrder, error)
+	Ship(id uint, req *models.ShipSalesOrderRequest, userID uint) (*models.SalesOrder, error)
+	Cancel(id uint) (*models.SalesOrder, error)
+}
+
+type salesOrderService struct {
+	orderRepo    repository.SalesOrderRepository
+	productRepo  repository.ProductRepository
+	alertService AlertService
+	wsHub        *websocket.Hub
+}
+
+func NewSalesOrderService(orderRepo repository.SalesOrderRepository, productRepo repository.ProductRepository, alertService AlertService, wsHub *websocket.Hub) SalesOrderService {
+	return &salesOrderService{
+		orderRepo:    orderRepo,
+		productRepo:  productRepo,
+		alertService: alertService,
+		wsHub:        wsHub,
+	}
+}
+
+func (s *salesOrderService) Create(req *models.CreateSalesOrderRequest, userID uint) (*models.SalesOrder, error) {
+	order := &models.SalesOrder{
+		Customer:    req.Customer,
+		WarehouseID: req.WarehouseID,
+		Notes:       req.Notes,
+		UserID:      userRef(userID),
+		Lines:       make([]models.SalesOrderLine, len(req.Lines)),
+	}
+
+	for i, line := range req.Lines {
+		product, err := s.productRepo.FindByID(line.ProductID)
+		if err != nil {
+			return nil, err
+		}
+
+		factor, ok := product.UnitFactor(line.Unit)
+		if !ok {
+			return nil, repository.ErrUnknownUnit
+		}
+		quantity := line.Quantity.Mul(factor)
+		if !product.ValidQuantity(quantity) {
+			return nil, repository.ErrQuantityPrecision
+		}
+
+		// Lines are priced per the line's unit, at the current product
+		// price unless overridden
+		unitPrice := product.Price.Mul(factor)
+		if line.UnitPrice != nil {
+			unitPrice = *line.UnitPrice
+		}
+
+		// An order is priced in one currency, that of its first product
+		if i == 0 {
+			order.Currency = product.Currency
+		} else if product.Currency != order.Currency {
+			return nil, ErrCurrencyMismatch
+		}
+
+		order.Lines[i] = models.SalesOrderLine{
+			ProductID:  line.ProductID,
+			Quantity:   quantity,
+			UnitPrice:  unitPrice,
+			Unit:       line.Unit,
+			UnitFactor: factor,
+		}
+	}
+
+	if err := s.orderRepo.Create(order); err != nil {
+		return nil, err
+	}
+
+	order, err := s.orderRepo.FindByID(order.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastOrder(websocket.EventSalesOrderCreated, order)
+
+	return order, nil
+}
+
+func (s *salesOrderService) GetByID(id uint) (*models.SalesOrder, error) {
+	return s.orderRepo.FindByID(id)
+}
+
+func (s *salesOrderService) List(status string, page, pageSize int) ([]models.SalesOrder, int64, error) {
+	return s.orderRepo.List(status, page, pageSize)
+}
+
+func (s *salesOrderService) Allocate(id, userID uint) (*models.SalesOrder, error) {
+	order, err := s.orderRepo.Allocate(id, userRef(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastAvailability(order)
+	s.broadcastOrder(websocket.EventSalesOrderUpdated, order)
+
+	return order, nil
+}
+
+func (s *salesOrderService) Pick(id uint) (*models.SalesOrder, error) {
+	order, err := s.orderRepo.Pick(id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastOrder(websocket.EventSalesOrderUpdated, order)
+
+	return order, nil
+}
+
+func (s *salesOrderService) Ship(id uint, req *models.ShipSalesOrderRequest, userID uint) (*models.SalesOrder, error) {
+	order, movements, err := s.orderRepo.Ship(id, models.SerialsByLine(req.Lines), userRef(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, movement := range withComponentMovements(movements) {
+		product, err := s.productRepo.FindByID(movement.ProductID)
+		if err != nil {
+			log.Printf("Error loading product %d after shipment: %v", movement.ProductID, err)
+			continue
+		}
+		if s.wsHub != nil {
+			s.wsHub.BroadcastMessage(websocket.EventStockUpdated, stockUpdatedEvent(product, movement.WarehouseID))
+		}
+		if s.alertService != nil {
+			s.alertService.Evaluate(product)
+		}
+	}
+
+	s.broadcastOrder(websocket.EventSalesOrderUpdated, order)
+
+	return order, nil
+}
+
+func (s *salesOrderService) Cancel(id uint) (*models.SalesOrder, error) {
+	order, err := s.orderRepo.Cancel(id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastAvailability(order)
+	s.broadcastOrder(websocket.EventSalesOrderUpdated, order)
+
+	return order, nil
+}
+
+// broadcastOrder notifies clients that a sales order was created or changed
+func (s *salesOrderService) broadcastOrder(event string, order *models.SalesOrder) {
+	if s.wsHub != nil {
+		s.wsHub.BroadcastMessage(event, order.ToResponse())
+	}
+}
+
+// broadcastAvailability notifies clients once per product on the order, and
+// per component of the bundles on it, that its available stock changed
+func (s *salesOrderService) broadcastAvailability(order *models.SalesOrder) {
+	if s.wsHub == nil {
+		return
+	}
+
+	notified := make(map[uint]bool)
+	var notify func(productID uint)
+	notify = func(productID uint) {
+		if notified[productID] {
+			return
+		}
+		notified[productID] = true
+
+		product, err := s.productRepo.FindByID(productID)
+		if err != nil {
+			log.Printf("Error loading product %d for availability event: %v", productID, err)
+			return
+		}
+		s.wsHub.BroadcastMessage(websocket.EventStockAvailability, product.ToResponse())
+
+		for _, component := range product.Components {
+			notify(component.ComponentID)
+		}
+	}
+	for _, line := range order.Lines {
+		notify(line.ProductID)
+	}
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+)
+
+type SerialNumberService interface {
+	Trace(serial string) (*models.SerialNumber, []models.StockMovement, []models.SerialEvent, error)
+}
+
+type serialNumberService struct {
+	serialRepo repository.SerialNumberRepository
+}
+
+func NewSerialNumberService(serialRepo repository.SerialNumberRepository) SerialNumberService {
+	return &serialNumberService{serialRepo: serialRepo}
+}
+
+// Trace returns a unit together with every movement that named it and its
+// status changes outside movements
+func (s *serialNumberService) Trace(serial string) (*models.SerialNumber, []models.StockMovement, []models.SerialEvent, error) {
+	unit, err := s.serialRepo.FindBySerial(serial)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	movements, err := s.serialRepo.ListMovements(unit.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	events, err := s.serialRepo.ListEvents(unit.ID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return unit, movements, events, nil
+}
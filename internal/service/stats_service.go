@@ -0,0 +1,133 @@
+package service
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/pkg/websocket"
+)
+
+// statsEventPrefixes are the prefixes of the event types broadcast when
+// something the stats are computed from changed
+var statsEventPrefixes = []string{"product.", "stock.", "category.", "stocktake."}
+
+type StatsService interface {
+	Get() (*models.InventoryStats, error)
+	RunBroadcaster(interval time.Duration)
+}
+
+type statsService struct {
+	statsRepo repository.StatsRepository
+	wsHub     *websocket.Hub
+	changed   chan struct{}
+}
+
+func NewStatsService(statsRepo repository.StatsRepository, wsHub *websocket.Hub) StatsService {
+	s := &statsService{
+		statsRepo: statsRepo,
+		wsHub:     wsHub,
+		changed:   make(chan struct{}, 1),
+	}
+	if wsHub != nil {
+		wsHub.OnBroadcast(s.onBroadcast)
+	}
+	return s
+}
+
+// Get computes the inventory stats, with totals summed from the category
+// stats
+func (s *statsService) Get() (*models.InventoryStats, error) {
+	categories, err := s.statsRepo.CategoryStats()
+	if err != nil {
+		return nil, err
+	}
+	stockValues, err := s.statsRepo.StockValues()
+	if err != nil {
+		return nil, err
+	}
+	retailValues, err := s.statsRepo.RetailValues()
+	if err != nil {
+		return nil, err
+	}
+
+	stats := &models.InventoryStats{
+		StockValues:  []models.CurrencyValue{},
+		RetailValues: retailValues,
+		Categories:   categories,
+		GeneratedAt:  time.Now(),
+	}
+	if stats.RetailValues == nil {
+		stats.RetailValues = []models.CurrencyValue{}
+	}
+	if stats.Categories == nil {
+		stats.Categories = []models.CategoryStats{}
+	}
+	byCategory := make(map[uint][]models.CurrencyValue)
+	for _, value := range stockValues {
+		byCategory[value.CategoryID] = append(byCategory[value.CategoryID], models.CurrencyValue{
+			Currency: value.Currency,
+			Value:    value.Value,
+		})
+		stats.StockValues = models.AddCurrencyValue(stats.StockValues, value.Currency, value.Value)
+	}
+	for i := range stats.Categories {
+		category := &stats.Categories[i]
+		category.StockValues = byCategory[category.CategoryID]
+		if category.StockValues == nil {
+			category.StockValues = []models.CurrencyValue{}
+		}
+
+		stats.Products += category.Products
+		stats.Units += category.Units
+		stats.OutOfStock += category.OutOfStock
+		stats.LowStock += category.LowStock
+	}
+
+	return stats, nil
+}
+
+// RunBroadcaster periodically pushes fresh stats to clients when products,
+// stock or categories changed since the last push, so bursts of changes are
+// coalesced into one recomputation. It blocks, so it is meant to be started
+// in its own goroutine.
+func (s *statsService) RunBroadcaster(interval time.Duration) {
+	if interval <= 0 || s.wsHub == nil {
+		log.Println("Stats broadcaster disabled")
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		select {
+		case <-s.changed:
+		default:
+			continue
+		}
+
+		stats, err := s.Get()
+		if err != nil {
+			log.Printf("Error computing inventory stats: %v", err)
+			continue
+		}
+		s.wsHub.BroadcastMessage(websocket.EventStatsUpdated, stats)
+	}
+}
+
+// onBroadcast marks the stats as changed when an event that affects them is
+// broadcast. It never blocks: a pending change already covers this one.
+func (s *statsService) onBroadcast(eventType string) {
+	for _, prefix := range statsEventPrefixes {
+		if strings.HasPrefix(eventType, prefix) {
+			select {
+			case s.changed <- struct{}{}:
+			default:
+			}
+			return
+		}
+	}
+}
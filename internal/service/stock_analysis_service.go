@@ -0,0 +1,260 @@
+package service
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+)
+
+var ErrInvalidABCShares = errors.New("a_share must be below b_share")
+
+// Defaults for the ABC analysis and dead-stock report
+const (
+	defaultABCWindowDays = 90
+	defaultABCAShare     = 80
+	defaultABCBShare     = 95
+	defaultDeadStockDays = 90
+)
+
+type StockAnalysisService interface {
+	ABC(query *models.ABCQuery) (*models.ABCReport, error)
+	DeadStock(query *models.DeadStockQuery) (*models.DeadStockReport, error)
+	Snapshot(at time.Time, categoryID *uint) (*models.SnapshotReport, error)
+}
+
+type stockAnalysisService struct {
+	analysisRepo repository.StockAnalysisRepository
+}
+
+func NewStockAnalysisService(analysisRepo repository.StockAnalysisRepository) StockAnalysisService {
+	return &stockAnalysisService{analysisRepo: analysisRepo}
+}
+
+// ABC ranks the products costed in a currency over the last WindowDays full
+// days by the value of the stock they held or, for turnover, the cost of the
+// stock they used up, and classes them by their cumulative share of the
+// total. A product whose
+// running total before it is under AShare percent is class A, under BShare
+// class B, and class C otherwise; products with nothing to rank by are
+// always class C.
+func (s *stockAnalysisService) ABC(query *models.ABCQuery) (*models.ABCReport, error) {
+	basis := query.Basis
+	if basis == "" {
+		basis = models.ABCByValue
+	}
+	windowDays := query.WindowDays
+	if windowDays == 0 {
+		windowDays = defaultABCWindowDays
+	}
+	aShare, bShare := query.AShare, query.BShare
+	if aShare == 0 {
+		aShare = defaultABCAShare
+	}
+	if bShare == 0 {
+		bShare = defaultABCBShare
+	}
+	if aShare >= bShare {
+		return nil, ErrInvalidABCShares
+	}
+	currency := query.Currency
+	if currency == "" {
+		currency = models.DefaultCurrency
+	}
+
+	now := time.Now()
+	to := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	from := to.AddDate(0, 0, -windowDays)
+
+	var categoryID *uint
+	if query.CategoryID > 0 {
+		categoryID = &query.CategoryID
+	}
+
+	products, err := s.analysisRepo.Usage(from, to, currency, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.ABCReport{
+		Basis:    basis,
+		Currency: currency,
+		From:     from,
+		To:       to,
+		AShare:   aShare,
+		BShare:   bShare,
+		Classes: map[string]models.ABCClassTotal{
+			"A": {}, "B": {}, "C": {},
+		},
+		Lines: make([]models.ABCLine, len(products)),
+	}
+
+	for i, p := range products {
+		line := models.ABCLine{
+			ProductID:    p.ProductID,
+			SKU:          p.SKU,
+			Name:         p.Name,
+			CategoryID:   p.CategoryID,
+			CategoryName: p.CategoryName,
+			AverageStock: models.QuantityFromFloat(p.AverageStock),
+			Usage:        p.Usage,
+		}
+		line.StockValue = p.AverageCost.Mul(line.AverageStock).Amount()
+		line.UsageValue = p.AverageCost.Mul(line.Usage).Amount()
+		if line.AverageStock > 0 {
+			turnover := math.Round(line.Usage.Float64()/line.AverageStock.Float64()*100) / 100
+			line.Turnover = &turnover
+		}
+		report.Lines[i] = line
+		report.Total += abcValue(basis, &line)
+	}
+
+	classifyABC(report)
+
+	return report, nil
+}
+
+// DeadStock lists the products holding stock that have not moved in the
+// last Days days
+func (s *stockAnalysisService) DeadStock(query *models.DeadStockQuery) (*models.DeadStockReport, error) {
+	days := query.Days
+	if days == 0 {
+		days = defaultDeadStockDays
+	}
+
+	now := time.Now()
+	since := now.AddDate(0, 0, -days)
+
+	var categoryID *uint
+	if query.CategoryID > 0 {
+		categoryID = &query.CategoryID
+	}
+
+	lines, err := s.analysisRepo.DeadStock(since, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.DeadStockReport{
+		Days:     days,
+		Since:    since,
+		Products: len(lines),
+		Lines:    lines,
+	}
+	if report.Lines == nil {
+		report.Lines = []models.DeadStockLine{}
+	}
+	report.Values = []models.CurrencyValue{}
+	for i := range report.Lines {
+		line := &report.Lines[i]
+		if line.LastMovementAt != nil {
+			idle := int(now.Sub(*line.LastMovementAt).Hours() / 24)
+			line.DaysIdle = &idle
+		}
+		report.Values = models.AddCurrencyValue(report.Values, line.Currency, line.StockValue)
+	}
+
+	return report, nil
+}
+
+// Snapshot lists every product's stock and price as of the given time, with
+// the units held and their value at list price per currency
+func (s *stockAnalysisService) Snapshot(at time.Time, categoryID *uint) (*models.SnapshotReport, error) {
+	lines, err := s.analysisRepo.Snapshot(at, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.SnapshotReport{
+		At:       at,
+		Products: len(lines),
+		Values:   []models.CurrencyValue{},
+		Lines:    lines,
+	}
+	if report.Lines == nil {
+		report.Lines = []models.SnapshotLine{}
+	}
+
+	values := make(map[string]models.Amount)
+	for _, line := range report.Lines {
+		report.Units += line.Stock
+		if line.Stock > 0 {
+			values[line.Currency] += line.Price.Mul(line.Stock)
+		}
+	}
+
+	currencies := make([]string, 0, len(values))
+	for currency := range values {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	for _, currency := range currencies {
+		report.Values = append(report.Values, models.CurrencyValue{Currency: currency, Value: values[currency]})
+	}
+
+	return report, nil
+}
+
+// classifyABC ranks the report's lines by its basis, highest first, and
+// sets their class and shares and the class totals from report.Total
+func classifyABC(report *models.ABCReport) {
+	sort.SliceStable(report.Lines, func(i, j int) bool {
+		return abcValue(report.Basis, &report.Lines[i]) > abcValue(report.Basis, &report.Lines[j])
+	})
+
+	var cumulative models.Amount
+	for i := range report.Lines {
+		line := &report.Lines[i]
+		value := abcValue(report.Basis, line)
+
+		switch {
+		case value <= 0 || report.Total <= 0:
+			line.Class = "C"
+		case share(cumulative, report.Total) < report.AShare:
+			line.Class = "A"
+		case share(cumulative, report.Total) < report.BShare:
+			line.Class = "B"
+		default:
+			line.Class = "C"
+		}
+
+		cumulative += value
+		line.Rank = i + 1
+		if report.Total > 0 {
+			line.Share = roundShare(share(value, report.Total))
+			line.CumulativeShare = roundShare(share(cumulative, report.Total))
+		}
+
+		class := report.Classes[line.Class]
+		class.Products++
+		class.Value += value
+		report.Classes[line.Class] = class
+	}
+	for name, class := range report.Classes {
+		if report.Total > 0 {
+			class.Share = roundShare(share(class.Value, report.Total))
+		}
+		report.Classes[name] = class
+	}
+}
+
+// abcValue returns the value a line is ranked by
+func abcValue(basis string, line *models.ABCLine) models.Amount {
+	if basis == models.ABCByTurnover {
+		return line.UsageValue
+	}
+	return line.StockValue
+}
+
+// share returns value as a percentage of total
+func share(value, total models.Amount) float64 {
+	return float64(value) / float64(total) * 100
+}
+
+// roundShare rounds a percentage to two decimal places
+func roundShare(share float64) float64 {
+	return math.Round(share*100) / 100
+}
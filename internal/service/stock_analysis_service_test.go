@@ -0,0 +1,69 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+)
+
+func TestClassifyABC(t *testing.T) {
+	// Lines are ranked by value, so they are listed here highest first except
+	// where the ordering is under test
+	tests := []struct {
+		name   string
+		basis  string
+		values []models.Amount
+		want   string
+	}{
+		{"running totals on the boundaries", models.ABCByValue, []models.Amount{50, 30, 15, 5}, "AABC"},
+		{"one product past the A share", models.ABCByValue, []models.Amount{85, 10, 5}, "ABC"},
+		{"ranked highest first", models.ABCByValue, []models.Amount{5, 15, 30, 50}, "AABC"},
+		{"nothing to rank by", models.ABCByValue, []models.Amount{100, 0}, "AC"},
+		{"no total", models.ABCByValue, []models.Amount{0, 0}, "CC"},
+		{"turnover ranks by usage", models.ABCByTurnover, []models.Amount{50, 30, 15, 5}, "AABC"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := &models.ABCReport{
+				Basis:   tt.basis,
+				AShare:  defaultABCAShare,
+				BShare:  defaultABCBShare,
+				Classes: map[string]models.ABCClassTotal{"A": {}, "B": {}, "C": {}},
+			}
+			for i, value := range tt.values {
+				// The other basis is reversed so ranking by it would differ
+				line := models.ABCLine{ProductID: uint(i + 1)}
+				if tt.basis == models.ABCByTurnover {
+					line.UsageValue, line.StockValue = value, tt.values[len(tt.values)-1-i]
+				} else {
+					line.StockValue, line.UsageValue = value, tt.values[len(tt.values)-1-i]
+				}
+				report.Lines = append(report.Lines, line)
+				report.Total += value
+			}
+
+			classifyABC(report)
+
+			got := ""
+			for i, line := range report.Lines {
+				if line.Rank != i+1 {
+					t.Errorf("line %d has rank %d", i, line.Rank)
+				}
+				got += line.Class
+			}
+			if got != tt.want {
+				t.Errorf("classifyABC(%v) classes = %q, want %q", tt.values, got, tt.want)
+			}
+
+			var products int
+			var value models.Amount
+			for _, class := range report.Classes {
+				products += class.Products
+				value += class.Value
+			}
+			if products != len(tt.values) || value != report.Total {
+				t.Errorf("class totals = %d products worth %v, want %d worth %v", products, value, len(tt.values), report.Total)
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"log"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/pkg/websocket"
+)
+
+type StocktakeService interface {
+	Create(req *models.CreateStocktakeRequest, userID uint) (*models.Stocktake, error)
+	GetByID(id uint) (*models.Stocktake, error)
+	List(warehouseID, categoryID *uint, status string, page, pageSize int) ([]models.Stocktake, int64, error)
+	SubmitCounts(id uint, req *models.SubmitStocktakeCountsRequest, userID uint) (*models.Stocktake, error)
+	Approve(id, userID uint) (*models.Stocktake, error)
+	Reject(id uint, req *models.RejectStocktakeRequest, userID uint) (*models.Stocktake, error)
+}
+
+type stocktakeService struct {
+	stocktakeRepo repository.StocktakeRepository
+	productRepo   repository.ProductRepository
+	alertService  AlertService
+	wsHub         *websocket.Hub
+}
+
+func NewStocktakeService(stocktakeRepo repository.StocktakeRepository, productRepo repository.ProductRepository, alertService AlertService, wsHub *websocket.Hub) StocktakeService {
+	return &stocktakeService{
+		stocktakeRepo: stocktakeRepo,
+		productRepo:   productRepo,
+		alertService:  alertService,
+		wsHub:         wsHub,
+	}
+}
+
+func (s *stocktakeService) Create(req *models.CreateStocktakeRequest, userID uint) (*models.Stocktake, error) {
+	stocktake := &models.Stocktake{
+		Notes:  req.Notes,
+		UserID: userRef(userID),
+	}
+	if req.WarehouseID > 0 {
+		stocktake.WarehouseID = &req.WarehouseID
+	}
+	if req.CategoryID > 0 {
+		stocktake.CategoryID = &req.CategoryID
+	}
+
+	if err := s.stocktakeRepo.Create(stocktake); err != nil {
+		return nil, err
+	}
+
+	return s.stocktakeRepo.FindByID(stocktake.ID)
+}
+
+func (s *stocktakeService) GetByID(id uint) (*models.Stocktake, error) {
+	return s.stocktakeRepo.FindByID(id)
+}
+
+func (s *stocktakeService) List(warehouseID, categoryID *uint, status string, page, pageSize int) ([]models.Stocktake, int64, error) {
+	return s.stocktakeRepo.List(warehouseID, categoryID, status, page, pageSize)
+}
+
+func (s *stocktakeService) SubmitCounts(id uint, req *models.SubmitStocktakeCountsRequest, userID uint) (*models.Stocktake, error) {
+	counts := make(map[uint]models.Quantity, len(req.Counts))
+	for _, count := range req.Counts {
+		counted, _, err := s.productRepo.BaseQuantity(count.ProductID, count.Unit, *count.Counted)
+		if err != nil {
+			return nil, err
+		}
+		counts[count.ProductID] = counted
+	}
+
+	return s.stocktakeRepo.SubmitCounts(id, counts, userRef(userID))
+}
+
+func (s *stocktakeService) Approve(id, userID uint) (*models.Stocktake, error) {
+	stocktake, movements, err := s.stocktakeRepo.Approve(id, userRef(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, movement := range movements {
+		product, err := s.productRepo.FindByID(movement.ProductID)
+		if err != nil {
+			log.Printf("Error loading product %d after stocktake approval: %v", movement.ProductID, err)
+			continue
+		}
+		if s.wsHub != nil {
+			s.wsHub.BroadcastMessage(websocket.EventStockUpdated, stockUpdatedEvent(product, movement.WarehouseID))
+		}
+		if s.alertService != nil {
+			s.alertService.Evaluate(product)
+		}
+	}
+
+	s.broadcastCompleted(stocktake)
+
+	return stocktake, nil
+}
+
+func (s *stocktakeService) Reject(id uint, req *models.RejectStocktakeRequest, userID uint) (*models.Stocktake, error) {
+	stocktake, err := s.stocktakeRepo.Reject(id, req.Reason, userRef(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastCompleted(stocktake)
+
+	return stocktake, nil
+}
+
+// broadcastCompleted notifies clients that a stocktake was approved or rejected
+func (s *stocktakeService) broadcastCompleted(stocktake *models.Stocktake) {
+	if s.wsHub != nil {
+		s.wsHub.BroadcastMessage(websocket.EventStocktakeCompleted, stocktake.ToResponse())
+	}
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+)
+
+type SupplierService interface {
+	Create(req *models.CreateSupplierRequest) (*models.Supplier, error)
+	GetByID(id uint) (*models.Supplier, error)
+	Update(id uint, req *models.UpdateSupplierRequest) (*models.Supplier, error)
+	Delete(id uint) error
+	List(page, pageSize int, search string) ([]models.Supplier, int64, error)
+}
+
+type supplierService struct {
+	supplierRepo repository.SupplierRepository
+}
+
+func NewSupplierService(supplierRepo repository.SupplierRepository) SupplierService {
+	return &supplierService{supplierRepo: supplierRepo}
+}
+
+func (s *supplierService) Create(req *models.CreateSupplierRequest) (*models.Supplier, error) {
+	supplier := &models.Supplier{
+		Name:        req.Name,
+		ContactName: req.ContactName,
+		Email:       req.Email,
+		Phone:       req.Phone,
+		Address:     req.Address,
+	}
+
+	if err := s.supplierRepo.Create(supplier); err != nil {
+		return nil, err
+	}
+
+	return supplier, nil
+}
+
+func (s *supplierService) GetByID(id uint) (*models.Supplier, error) {
+	return s.supplierRepo.FindByID(id)
+}
+
+func (s *supplierService) Update(id uint, req *models.UpdateSupplierRequest) (*models.Supplier, error) {
+	supplier, err := s.supplierRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Name != "" {
+		supplier.Name = req.Name
+	}
+	if req.ContactName != "" {
+		supplier.ContactName = req.ContactName
+	}
+	if req.Email != "" {
+		supplier.Email = req.Email
+	}
+	if req.Phone != "" {
+		supplier.Phone = req.Phone
+	}
+	if req.Address != "" {
+		supplier.Address = req.Address
+	}
+
+	if err := s.supplierRepo.Update(supplier); err != nil {
+		return nil, err
+	}
+
+	return supplier, nil
+}
+
+func (s *supplierService) Delete(id uint) error {
+	if _, err := s.supplierRepo.FindByID(id); err != nil {
+		return err
+	}
+
+	return s.supplierRepo.Delete(id)
+}
+
+func (s *supplierService) List(page, pageSize int, search string) ([]models.Supplier, int64, error) {
+	return s.supplierRepo.List(page, pageSize, search)
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+)
+
+type TaxService interface {
+	Create(req *models.CreateTaxClassRequest) (*models.TaxClass, error)
+	GetByID(id uint) (*models.TaxClass, error)
+	Update(id uint, req *models.UpdateTaxClassRequest) (*models.TaxClass, error)
+	Delete(id uint) error
+	List(page, pageSize int, search string) ([]models.TaxClass, int64, error)
+	SetRates(id uint, req *models.SetTaxRatesRequest) (*models.TaxClass, error)
+	ApplyTax(products []models.Product, jurisdiction string) (map[uint]models.ProductTax, error)
+}
+
+type taxService struct {
+	taxRepo repository.TaxRepository
+}
+
+func NewTaxService(taxRepo repository.TaxRepository) TaxService {
+	return &taxService{taxRepo: taxRepo}
+}
+
+func (s *taxService) Create(req *models.CreateTaxClassRequest) (*models.TaxClass, error) {
+	class := &models.TaxClass{
+		Code:        req.Code,
+		Name:        req.Name,
+		Description: req.Description,
+	}
+
+	if err := s.taxRepo.Create(class); err != nil {
+		return nil, err
+	}
+
+	return class, nil
+}
+
+func (s *taxService) GetByID(id uint) (*models.TaxClass, error) {
+	return s.taxRepo.FindByID(id)
+}
+
+func (s *taxService) Update(id uint, req *models.UpdateTaxClassRequest) (*models.TaxClass, error) {
+	class, err := s.taxRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Code != "" {
+		class.Code = req.Code
+	}
+	if req.Name != "" {
+		class.Name = req.Name
+	}
+	if req.Description != "" {
+		class.Description = req.Description
+	}
+
+	if err := s.taxRepo.Update(class); err != nil {
+		return nil, err
+	}
+
+	return class, nil
+}
+
+func (s *taxService) Delete(id uint) error {
+	return s.taxRepo.Delete(id)
+}
+
+func (s *taxService) List(page, pageSize int, search string) ([]models.TaxClass, int64, error) {
+	return s.taxRepo.List(page, pageSize, search)
+}
+
+func (s *taxService) SetRates(id uint, req *models.SetTaxRatesRequest) (*models.TaxClass, error) {
+	if err := s.taxRepo.SetRates(id, req.Rates); err != nil {
+		return nil, err
+	}
+
+	return s.taxRepo.FindByID(id)
+}
+
+// ApplyTax splits each product's price into net, tax and gross in the
+// jurisdiction, looking up the rates of all their tax classes in one query
+func (s *taxService) ApplyTax(products []models.Product, jurisdiction string) (map[uint]models.ProductTax, error) {
+	jurisdiction = models.NormalizeJurisdiction(jurisdiction)
+
+	var classIDs []uint
+	for _, product := range products {
+		if product.TaxClassID != nil {
+			classIDs = append(classIDs, *product.TaxClassID)
+		}
+	}
+	rates, err := s.taxRepo.FindRates(jurisdiction, classIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	taxes := make(map[uint]models.ProductTax, len(products))
+	for _, product := range products {
+		var rate *models.TaxRate
+		if product.TaxClassID != nil {
+			if r, ok := rates[*product.TaxClassID]; ok {
+				rate = &r
+			}
+		}
+		taxes[product.ID] = product.ApplyTax(jurisdiction, rate)
+	}
+	return taxes, nil
+}
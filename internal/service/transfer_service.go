@@ -0,0 +1,135 @@
+package service
+
+import (
+	"log"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/pkg/websocket"
+)
+
+type TransferService interface {
+	Create(req *models.CreateTransferRequest, userID uint) (*models.Transfer, error)
+	GetByID(id uint) (*models.Transfer, error)
+	List(warehouseID *uint, status string, page, pageSize int) ([]models.Transfer, int64, error)
+	Dispatch(id uint, req *models.DispatchTransferRequest, userID uint) (*models.Transfer, error)
+	Receive(id, userID uint) (*models.Transfer, error)
+	Cancel(id uint) (*models.Transfer, error)
+}
+
+type transferService struct {
+	transferRepo repository.TransferRepository
+	productRepo  repository.ProductRepository
+	alertService AlertService
+	wsHub        *websocket.Hub
+}
+
+func NewTransferService(transferRepo repository.TransferRepository, productRepo repository.ProductRepository, alertService AlertService, wsHub *websocket.Hub) TransferService {
+	return &transferService{
+		transferRepo: transferRepo,
+		productRepo:  productRepo,
+		alertService: alertService,
+		wsHub:        wsHub,
+	}
+}
+
+func (s *transferService) Create(req *models.CreateTransferRequest, userID uint) (*models.Transfer, error) {
+	transfer := &models.Transfer{
+		SourceWarehouseID:      req.SourceWarehouseID,
+		DestinationWarehouseID: req.DestinationWarehouseID,
+		Notes:                  req.Notes,
+		UserID:                 userRef(userID),
+		Lines:                  make([]models.TransferLine, len(req.Lines)),
+	}
+	for i, line := range req.Lines {
+		quantity, _, err := s.productRepo.BaseQuantity(line.ProductID, line.Unit, line.Quantity)
+		if err != nil {
+			return nil, err
+		}
+		transfer.Lines[i] = models.TransferLine{
+			ProductID: line.ProductID,
+			Quantity:  quantity,
+		}
+	}
+
+	if err := s.transferRepo.Create(transfer); err != nil {
+		return nil, err
+	}
+
+	transfer, err := s.transferRepo.FindByID(transfer.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastTransfer(websocket.EventTransferCreated, transfer)
+
+	return transfer, nil
+}
+
+func (s *transferService) GetByID(id uint) (*models.Transfer, error) {
+	return s.transferRepo.FindByID(id)
+}
+
+func (s *transferService) List(warehouseID *uint, status string, page, pageSize int) ([]models.Transfer, int64, error) {
+	return s.transferRepo.List(warehouseID, status, page, pageSize)
+}
+
+func (s *transferService) Dispatch(id uint, req *models.DispatchTransferRequest, userID uint) (*models.Transfer, error) {
+	transfer, movements, err := s.transferRepo.Dispatch(id, models.SerialsByLine(req.Lines), userRef(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	s.stockChanged(movements)
+	s.broadcastTransfer(websocket.EventTransferUpdated, transfer)
+
+	return transfer, nil
+}
+
+func (s *transferService) Receive(id, userID uint) (*models.Transfer, error) {
+	transfer, movements, err := s.transferRepo.Receive(id, userRef(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	s.stockChanged(movements)
+	s.broadcastTransfer(websocket.EventTransferUpdated, transfer)
+
+	return transfer, nil
+}
+
+func (s *transferService) Cancel(id uint) (*models.Transfer, error) {
+	transfer, err := s.transferRepo.Cancel(id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastTransfer(websocket.EventTransferUpdated, transfer)
+
+	return transfer, nil
+}
+
+// stockChanged broadcasts stock.updated for each movement's location and
+// re-evaluates alerts
+func (s *transferService) stockChanged(movements []models.StockMovement) {
+	for _, movement := range movements {
+		product, err := s.productRepo.FindByID(movement.ProductID)
+		if err != nil {
+			log.Printf("Error loading product %d after transfer: %v", movement.ProductID, err)
+			continue
+		}
+		if s.wsHub != nil {
+			s.wsHub.BroadcastMessage(websocket.EventStockUpdated, stockUpdatedEvent(product, movement.WarehouseID))
+		}
+		if s.alertService != nil {
+			s.alertService.Evaluate(product)
+		}
+	}
+}
+
+// broadcastTransfer notifies clients that a transfer was created or changed
+func (s *transferService) broadcastTransfer(event string, transfer *models.Transfer) {
+	if s.wsHub != nil {
+		s.wsHub.BroadcastMessage(event, transfer.ToResponse())
+	}
+}
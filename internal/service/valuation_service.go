@@ -0,0 +1,113 @@
+package service
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+)
+
+var ErrInvalidValuationPeriod = errors.New("valuation period must start before its as-of date")
+
+type ValuationService interface {
+	Report(method string, from, asOf *time.Time, categoryID *uint) (*models.ValuationReport, error)
+}
+
+type valuationService struct {
+	valuationRepo repository.ValuationRepository
+	defaultMethod string
+}
+
+func NewValuationService(valuationRepo repository.ValuationRepository, defaultMethod string) ValuationService {
+	return &valuationService{
+		valuationRepo: valuationRepo,
+		defaultMethod: defaultMethod,
+	}
+}
+
+// Report values the stock held at asOf, now if nil, and the cost of goods
+// sold since from, the start of asOf's month if nil, broken down by category
+// and currency
+func (s *valuationService) Report(method string, from, asOf *time.Time, categoryID *uint) (*models.ValuationReport, error) {
+	if method == "" {
+		method = s.defaultMethod
+	}
+
+	end := time.Now()
+	if asOf != nil {
+		end = *asOf
+	}
+	start := time.Date(end.Year(), end.Month(), 1, 0, 0, 0, 0, end.Location())
+	if from != nil {
+		start = *from
+	}
+	if start.After(end) {
+		return nil, ErrInvalidValuationPeriod
+	}
+
+	products, err := s.valuationRepo.Valuation(method, start, end, categoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.ValuationReport{
+		Method:     method,
+		From:       start,
+		AsOf:       end,
+		Categories: []models.CategoryValuation{},
+		Totals:     []models.ValuationTotal{},
+	}
+	type categoryKey struct {
+		id       uint
+		currency string
+	}
+	categories := make(map[categoryKey]*models.CategoryValuation)
+	for _, p := range products {
+		key := categoryKey{id: p.CategoryID, currency: p.Currency}
+		category, ok := categories[key]
+		if !ok {
+			category = &models.CategoryValuation{
+				CategoryID:   p.CategoryID,
+				CategoryName: p.CategoryName,
+				Currency:     p.Currency,
+			}
+			categories[key] = category
+		}
+		category.Products++
+		category.Quantity += p.Quantity
+		category.Value += p.Value
+		category.CostOfGoodsSold += p.CostOfGoodsSold
+	}
+
+	totals := make(map[string]*models.ValuationTotal)
+	for _, category := range categories {
+		report.Categories = append(report.Categories, *category)
+		report.Quantity += category.Quantity
+
+		total, ok := totals[category.Currency]
+		if !ok {
+			total = &models.ValuationTotal{Currency: category.Currency}
+			totals[category.Currency] = total
+		}
+		total.Value += category.Value
+		total.CostOfGoodsSold += category.CostOfGoodsSold
+	}
+	sort.Slice(report.Categories, func(i, j int) bool {
+		a, b := report.Categories[i], report.Categories[j]
+		if a.CategoryID != b.CategoryID {
+			return a.CategoryID < b.CategoryID
+		}
+		return a.Currency < b.Currency
+	})
+
+	for _, total := range totals {
+		report.Totals = append(report.Totals, *total)
+	}
+	sort.Slice(report.Totals, func(i, j int) bool {
+		return report.Totals[i].Currency < report.Totals[j].Currency
+	})
+
+	return report, nil
+}
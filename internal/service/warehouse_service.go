@@ -0,0 +1,110 @@
+package service
+
+import (
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/pkg/websocket"
+)
+
+type WarehouseService interface {
+	Create(req *models.CreateWarehouseRequest) (*models.Warehouse, error)
+	GetByID(id uint) (*models.Warehouse, error)
+	Update(id uint, req *models.UpdateWarehouseRequest) (*models.Warehouse, error)
+	Delete(id uint) error
+	List(page, pageSize int) ([]models.Warehouse, int64, error)
+	ListStock(id uint, page, pageSize int) ([]models.ProductStock, int64, error)
+}
+
+type warehouseService struct {
+	warehouseRepo repository.WarehouseRepository
+	wsHub         *websocket.Hub
+}
+
+func NewWarehouseService(warehouseRepo repository.WarehouseRepository, wsHub *websocket.Hub) WarehouseService {
+	return &warehouseService{
+		warehouseRepo: warehouseRepo,
+		wsHub:         wsHub,
+	}
+}
+
+func (s *warehouseService) Create(req *models.CreateWarehouseRequest) (*models.Warehouse, error) {
+	warehouse := &models.Warehouse{
+		Code:      req.Code,
+		Name:      req.Name,
+		Address:   req.Address,
+		IsDefault: req.IsDefault,
+	}
+
+	if err := s.warehouseRepo.Create(warehouse); err != nil {
+		return nil, err
+	}
+
+	// Broadcast WebSocket event
+	if s.wsHub != nil {
+		s.wsHub.BroadcastMessage(websocket.EventWarehouseCreated, warehouse.ToResponse())
+	}
+
+	return warehouse, nil
+}
+
+func (s *warehouseService) GetByID(id uint) (*models.Warehouse, error) {
+	return s.warehouseRepo.FindByID(id)
+}
+
+func (s *warehouseService) Update(id uint, req *models.UpdateWarehouseRequest) (*models.Warehouse, error) {
+	warehouse, err := s.warehouseRepo.FindByID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Code != "" {
+		warehouse.Code = req.Code
+	}
+	if req.Name != "" {
+		warehouse.Name = req.Name
+	}
+	if req.Address != "" {
+		warehouse.Address = req.Address
+	}
+	// The default can be moved to another warehouse but not cleared
+	if req.IsDefault != nil && *req.IsDefault {
+		warehouse.IsDefault = true
+	}
+
+	if err := s.warehouseRepo.Update(warehouse); err != nil {
+		return nil, err
+	}
+
+	// Broadcast WebSocket event
+	if s.wsHub != nil {
+		s.wsHub.BroadcastMessage(websocket.EventWarehouseUpdated, warehouse.ToResponse())
+	}
+
+	return warehouse, nil
+}
+
+func (s *warehouseService) Delete(id uint) error {
+	if err := s.warehouseRepo.Delete(id); err != nil {
+		return err
+	}
+
+	// Broadcast WebSocket event
+	if s.wsHub != nil {
+		s.wsHub.BroadcastMessage(websocket.EventWarehouseDeleted, map[string]uint{"id": id})
+	}
+
+	return nil
+}
+
+func (s *warehouseService) List(page, pageSize int) ([]models.Warehouse, int64, error) {
+	return s.warehouseRepo.List(page, pageSize)
+}
+
+func (s *warehouseService) ListStock(id uint, page, pageSize int) ([]models.ProductStock, int64, error) {
+	// Verify warehouse exists
+	if _, err := s.warehouseRepo.FindByID(id); err != nil {
+		return nil, 0, err
+	}
+
+	return s.warehouseRepo.ListStock(id, page, pageSize)
+}
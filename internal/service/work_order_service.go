@@ -0,0 +1,114 @@
+package service
+
+import (
+	"log"
+
+	"github.com/brunobarlari/inventorypulse/internal/domain/models"
+	"github.com/brunobarlari/inventorypulse/internal/repository"
+	"github.com/brunobarlari/inventorypulse/pkg/websocket"
+)
+
+type WorkOrderService interface {
+	Create(req *models.CreateWorkOrderRequest, userID uint) (*models.WorkOrder, error)
+	GetByID(id uint) (*models.WorkOrder, error)
+	List(productID *uint, status string, page, pageSize int) ([]models.WorkOrder, int64, error)
+	Complete(id uint, req *models.CompleteWorkOrderRequest, userID uint) (*models.WorkOrder, error)
+	Cancel(id uint) (*models.WorkOrder, error)
+}
+
+type workOrderService struct {
+	workOrderRepo repository.WorkOrderRepository
+	productRepo   repository.ProductRepository
+	alertService  AlertService
+	wsHub         *websocket.Hub
+}
+
+func NewWorkOrderService(workOrderRepo repository.WorkOrderRepository, productRepo repository.ProductRepository, alertService AlertService, wsHub *websocket.Hub) WorkOrderService {
+	return &workOrderService{
+		workOrderRepo: workOrderRepo,
+		productRepo:   productRepo,
+		alertService:  alertService,
+		wsHub:         wsHub,
+	}
+}
+
+func (s *workOrderService) Create(req *models.CreateWorkOrderRequest, userID uint) (*models.WorkOrder, error) {
+	order := &models.WorkOrder{
+		ProductID:   req.ProductID,
+		WarehouseID: req.WarehouseID,
+		Quantity:    req.Quantity,
+		Notes:       req.Notes,
+		UserID:      userRef(userID),
+		Components:  make([]models.WorkOrderComponent, len(req.Components)),
+	}
+	for i, component := range req.Components {
+		order.Components[i] = models.WorkOrderComponent{
+			ProductID:       component.ProductID,
+			QuantityPerUnit: component.QuantityPerUnit,
+		}
+	}
+
+	if err := s.workOrderRepo.Create(order); err != nil {
+		return nil, err
+	}
+
+	order, err := s.workOrderRepo.FindByID(order.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastWorkOrder(websocket.EventWorkOrderCreated, order)
+
+	return order, nil
+}
+
+func (s *workOrderService) GetByID(id uint) (*models.WorkOrder, error) {
+	return s.workOrderRepo.FindByID(id)
+}
+
+func (s *workOrderService) List(productID *uint, status string, page, pageSize int) ([]models.WorkOrder, int64, error) {
+	return s.workOrderRepo.List(productID, status, page, pageSize)
+}
+
+func (s *workOrderService) Complete(id uint, req *models.CompleteWorkOrderRequest, userID uint) (*models.WorkOrder, error) {
+	order, movements, err := s.workOrderRepo.Complete(id, req, userRef(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, movement := range withComponentMovements(movements) {
+		product, err := s.productRepo.FindByID(movement.ProductID)
+		if err != nil {
+			log.Printf("Error loading product %d after work order completion: %v", movement.ProductID, err)
+			continue
+		}
+		if s.wsHub != nil {
+			s.wsHub.BroadcastMessage(websocket.EventStockUpdated, stockUpdatedEvent(product, movement.WarehouseID))
+		}
+		if s.alertService != nil {
+			s.alertService.Evaluate(product)
+		}
+	}
+
+	s.broadcastWorkOrder(websocket.EventWorkOrderUpdated, order)
+
+	return order, nil
+}
+
+func (s *workOrderService) Cancel(id uint) (*models.WorkOrder, error) {
+	order, err := s.workOrderRepo.Cancel(id)
+	if err != nil {
+		return nil, err
+	}
+
+	s.broadcastWorkOrder(websocket.EventWorkOrderUpdated, order)
+
+	return order, nil
+}
+
+// broadcastWorkOrder notifies clients that a work order was created or changed
+func (s *workOrderService) broadcastWorkOrder(event string, order *models.WorkOrder) {
+	if s.wsHub != nil {
+		s.wsHub.BroadcastMessage(event, order.ToResponse())
+	}
+}
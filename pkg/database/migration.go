@@ -17,6 +17,39 @@ func RunMigrations(db *gorm.DB) error {
 		&models.Product{},
 		&models.ProductHistory{},
 		&models.ProductCategory{},
+		&models.Warehouse{},
+		&models.ProductStock{},
+		&models.StockMovement{},
+		&models.Reservation{},
+		&models.StockAlert{},
+		&models.Supplier{},
+		&models.PurchaseOrder{},
+		&models.PurchaseOrderLine{},
+		&models.SalesOrder{},
+		&models.SalesOrderLine{},
+		&models.Transfer{},
+		&models.TransferLine{},
+		&models.Lot{},
+		&models.StockMovementLot{},
+		&models.SerialNumber{},
+		&models.StockMovementSerial{},
+		&models.SerialEvent{},
+		&models.ProductOption{},
+		&models.ProductOptionValue{},
+		&models.BundleComponent{},
+		&models.ProductUnit{},
+		&models.WorkOrder{},
+		&models.WorkOrderComponent{},
+		&models.WorkOrderCompletion{},
+		&models.Stocktake{},
+		&models.StocktakeLine{},
+		&models.PriceList{},
+		&models.PriceListPrice{},
+		&models.ScheduledPriceChange{},
+		&models.TaxClass{},
+		&models.TaxRate{},
+		&models.ReturnAuthorization{},
+		&models.ReturnEvent{},
 	)
 
 	if err != nil {
@@ -1,11 +1,13 @@
 package database
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/brunobarlari/inventorypulse/internal/config"
 	"github.com/brunobarlari/inventorypulse/internal/domain/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // RunSeeder seeds initial data into the database
@@ -27,11 +29,26 @@ func RunSeeder(db *gorm.DB, cfg *config.Config) error {
 		return err
 	}
 
+	// Seed default warehouse
+	if err := seedWarehouses(db); err != nil {
+		return err
+	}
+
 	// Seed sample products
 	if err := seedProducts(db); err != nil {
 		return err
 	}
 
+	// Place stock not yet held at any warehouse into the default one
+	if err := backfillProductStocks(db); err != nil {
+		return err
+	}
+
+	// Register the units held by serialized products without serial numbers
+	if err := seedSerialNumbers(db); err != nil {
+		return err
+	}
+
 	log.Println("Database seeding completed successfully")
 	return nil
 }
@@ -111,6 +128,88 @@ func seedCategories(db *gorm.DB) error {
 	return nil
 }
 
+func seedWarehouses(db *gorm.DB) error {
+	var count int64
+	db.Model(&models.Warehouse{}).Count(&count)
+
+	if count > 0 {
+		log.Println("Warehouses already exist, skipping warehouse seeding")
+		return nil
+	}
+
+	warehouse := &models.Warehouse{
+		Code:      "MAIN",
+		Name:      "Main Warehouse",
+		IsDefault: true,
+	}
+
+	if err := db.Create(warehouse).Error; err != nil {
+		return err
+	}
+
+	log.Printf("Default warehouse created: %s", warehouse.Code)
+	return nil
+}
+
+// backfillProductStocks assigns the stock of products without any
+// per-warehouse rows to the default warehouse
+func backfillProductStocks(db *gorm.DB) error {
+	var warehouse models.Warehouse
+	if err := db.Where("is_default = ?", true).First(&warehouse).Error; err != nil {
+		return err
+	}
+
+	result := db.Exec(`INSERT INTO product_stocks (product_id, warehouse_id, quantity, updated_at)
+		SELECT p.id, ?, p.stock, NOW() FROM products p
+		WHERE p.deleted_at IS NULL AND p.stock <> 0
+		AND NOT EXISTS (SELECT 1 FROM product_stocks ps WHERE ps.product_id = p.id)`, warehouse.ID)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		log.Printf("Assigned stock of %d products to warehouse %s", result.RowsAffected, warehouse.Code)
+	}
+
+	return nil
+}
+
+// seedSerialNumbers gives every unit of a serialized product that has no
+// serial numbers yet a generated serial of the form SKU-00001
+func seedSerialNumbers(db *gorm.DB) error {
+	var products []models.Product
+	err := db.Preload("Stocks").Where("serialized = ? AND stock > 0", true).
+		Where("NOT EXISTS (SELECT 1 FROM serial_numbers sn WHERE sn.product_id = products.id)").
+		Find(&products).Error
+	if err != nil {
+		return err
+	}
+
+	for _, product := range products {
+		var units []models.SerialNumber
+		for _, stock := range product.Stocks {
+			warehouseID := stock.WarehouseID
+			for i := int64(0); models.Units(i) < stock.Quantity; i++ {
+				units = append(units, models.SerialNumber{
+					ProductID:   product.ID,
+					Serial:      fmt.Sprintf("%s-%05d", product.SKU, len(units)+1),
+					Status:      models.SerialInStock,
+					WarehouseID: &warehouseID,
+				})
+			}
+		}
+		if len(units) == 0 {
+			continue
+		}
+		if err := db.Omit(clause.Associations).Create(&units).Error; err != nil {
+			return err
+		}
+		log.Printf("Registered %d serial numbers for %s", len(units), product.SKU)
+	}
+
+	return nil
+}
+
 func seedProducts(db *gorm.DB) error {
 	var count int64
 	db.Model(&models.Product{}).Count(&count)
@@ -121,16 +220,16 @@ func seedProducts(db *gorm.DB) error {
 	}
 
 	products := []models.Product{
-		{Name: "Laptop Pro 15", Description: "High-performance laptop with 15-inch display", SKU: "ELEC-001", Stock: 50, Price: 1299.99, CategoryID: 1},
-		{Name: "Wireless Mouse", Description: "Ergonomic wireless mouse with USB receiver", SKU: "ELEC-002", Stock: 200, Price: 29.99, CategoryID: 1},
-		{Name: "USB-C Hub", Description: "7-in-1 USB-C hub with HDMI and SD card reader", SKU: "ELEC-003", Stock: 150, Price: 49.99, CategoryID: 1},
-		{Name: "Cotton T-Shirt", Description: "100% cotton casual t-shirt", SKU: "CLTH-001", Stock: 300, Price: 19.99, CategoryID: 2},
-		{Name: "Denim Jeans", Description: "Classic fit denim jeans", SKU: "CLTH-002", Stock: 150, Price: 59.99, CategoryID: 2},
-		{Name: "Programming in Go", Description: "Complete guide to Go programming language", SKU: "BOOK-001", Stock: 75, Price: 39.99, CategoryID: 3},
-		{Name: "Clean Code", Description: "A handbook of agile software craftsmanship", SKU: "BOOK-002", Stock: 100, Price: 34.99, CategoryID: 3},
-		{Name: "Garden Tool Set", Description: "5-piece stainless steel garden tool set", SKU: "HOME-001", Stock: 80, Price: 44.99, CategoryID: 4},
-		{Name: "LED Desk Lamp", Description: "Adjustable LED desk lamp with USB charging", SKU: "HOME-002", Stock: 120, Price: 35.99, CategoryID: 4},
-		{Name: "Yoga Mat", Description: "Non-slip yoga mat with carrying strap", SKU: "SPRT-001", Stock: 200, Price: 24.99, CategoryID: 5},
+		{Name: "Laptop Pro 15", Description: "High-performance laptop with 15-inch display", SKU: "ELEC-001", Stock: models.Units(50), Price: 129999, CategoryID: 1, Serialized: true},
+		{Name: "Wireless Mouse", Description: "Ergonomic wireless mouse with USB receiver", SKU: "ELEC-002", Stock: models.Units(200), Price: 2999, CategoryID: 1},
+		{Name: "USB-C Hub", Description: "7-in-1 USB-C hub with HDMI and SD card reader", SKU: "ELEC-003", Stock: models.Units(150), Price: 4999, CategoryID: 1},
+		{Name: "Cotton T-Shirt", Description: "100% cotton casual t-shirt", SKU: "CLTH-001", Stock: models.Units(300), Price: 1999, CategoryID: 2},
+		{Name: "Denim Jeans", Description: "Classic fit denim jeans", SKU: "CLTH-002", Stock: models.Units(150), Price: 5999, CategoryID: 2},
+		{Name: "Programming in Go", Description: "Complete guide to Go programming language", SKU: "BOOK-001", Stock: models.Units(75), Price: 3999, CategoryID: 3},
+		{Name: "Clean Code", Description: "A handbook of agile software craftsmanship", SKU: "BOOK-002", Stock: models.Units(100), Price: 3499, CategoryID: 3},
+		{Name: "Garden Tool Set", Description: "5-piece stainless steel garden tool set", SKU: "HOME-001", Stock: models.Units(80), Price: 4499, CategoryID: 4},
+		{Name: "LED Desk Lamp", Description: "Adjustable LED desk lamp with USB charging", SKU: "HOME-002", Stock: models.Units(120), Price: 3599, CategoryID: 4},
+		{Name: "Yoga Mat", Description: "Non-slip yoga mat with carrying strap", SKU: "SPRT-001", Stock: models.Units(200), Price: 2499, CategoryID: 5},
 	}
 
 	for _, product := range products {
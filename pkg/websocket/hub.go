@@ -8,13 +8,31 @@ import (
 
 // Event types
 const (
-	EventProductCreated = "product.created"
-	EventProductUpdated = "product.updated"
-	EventProductDeleted = "product.deleted"
-	EventStockUpdated   = "stock.updated"
-	EventCategoryCreated = "category.created"
-	EventCategoryUpdated = "category.updated"
-	EventCategoryDeleted = "category.deleted"
+	EventProductCreated       = "product.created"
+	EventProductUpdated       = "product.updated"
+	EventProductDeleted       = "product.deleted"
+	EventStockUpdated         = "stock.updated"
+	EventStockAvailability    = "stock.availability"
+	EventStockLow             = "stock.low"
+	EventStockOut             = "stock.out"
+	EventAlertResolved        = "alert.resolved"
+	EventCategoryCreated      = "category.created"
+	EventCategoryUpdated      = "category.updated"
+	EventCategoryDeleted      = "category.deleted"
+	EventWarehouseCreated     = "warehouse.created"
+	EventWarehouseUpdated     = "warehouse.updated"
+	EventWarehouseDeleted     = "warehouse.deleted"
+	EventPurchaseOrderUpdated = "purchase_order.updated"
+	EventSalesOrderCreated    = "sales_order.created"
+	EventSalesOrderUpdated    = "sales_order.updated"
+	EventTransferCreated      = "transfer.created"
+	EventTransferUpdated      = "transfer.updated"
+	EventWorkOrderCreated     = "work_order.created"
+	EventWorkOrderUpdated     = "work_order.updated"
+	EventStocktakeCompleted   = "stocktake.completed"
+	EventReturnCreated        = "return.created"
+	EventReturnUpdated        = "return.updated"
+	EventStatsUpdated         = "stats.updated"
 )
 
 // Message represents a WebSocket message
@@ -37,6 +55,9 @@ type Hub struct {
 	// Unregister requests from clients
 	unregister chan *Client
 
+	// Listeners notified of each broadcast event type
+	listeners []func(eventType string)
+
 	// Mutex for thread-safe operations
 	mu sync.RWMutex
 }
@@ -98,9 +119,25 @@ func (h *Hub) BroadcastMessage(eventType string, payload interface{}) {
 		return
 	}
 
+	h.mu.RLock()
+	listeners := h.listeners
+	h.mu.RUnlock()
+	for _, listener := range listeners {
+		listener(eventType)
+	}
+
 	h.broadcast <- data
 }
 
+// OnBroadcast registers a listener called with the type of each message
+// broadcast. Listeners run on the broadcasting goroutine, so they must not
+// block.
+func (h *Hub) OnBroadcast(listener func(eventType string)) {
+	h.mu.Lock()
+	h.listeners = append(h.listeners, listener)
+	h.mu.Unlock()
+}
+
 // GetClientCount returns the number of connected clients
 func (h *Hub) GetClientCount() int {
 	h.mu.RLock()